@@ -0,0 +1,61 @@
+package eventbrite
+
+import (
+	"errors"
+	"net/http"
+
+	"golang.org/x/net/context"
+	"golang.org/x/oauth2"
+)
+
+// ErrTokenMissing is returned for requests made by a client configured without a token
+var ErrTokenMissing = errors.New("eventbrite: Token missing")
+
+// WithToken configures a Eventbrite API client with a personal OAuth token
+func WithToken(token string) ClientOption {
+	return WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token, TokenType: "Bearer"}))
+}
+
+// WithTokenSource configures a Eventbrite API client to authenticate with the tokens of ts. Tokens
+// are reused until they expire, then ts is asked for a new one, so a refreshing source such as
+// the one of oauth2.Config.TokenSource keeps the client authenticated.
+func WithTokenSource(ts oauth2.TokenSource) ClientOption {
+	return func(c *Client) error {
+		c.tokenSource = oauth2.ReuseTokenSource(nil, ts)
+		return nil
+	}
+}
+
+type tokenKey struct{}
+
+// WithRequestToken returns a context whose requests authenticate with the given token instead
+// of the token of the client, e.g. to act on behalf of one of many organizers. They wait on the
+// rate limiter of that token, see WithTokenRateLimiter.
+func WithRequestToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, &oauth2.Token{AccessToken: token, TokenType: "Bearer"})
+}
+
+// token returns the token to authenticate the request made with ctx
+func (c *Client) token(ctx context.Context) (*oauth2.Token, error) {
+	if t, ok := ctx.Value(tokenKey{}).(*oauth2.Token); ok {
+		return t, nil
+	}
+	if c.tokenSource == nil {
+		return nil, ErrTokenMissing
+	}
+	return c.tokenSource.Token()
+}
+
+// authorize sets the Authorization header of the request. The token is never put in the URL,
+// where it would end up in proxy and access logs.
+func (c *Client) authorize(ctx context.Context, req *http.Request) error {
+	t, err := c.token(ctx)
+	if err != nil {
+		return err
+	}
+	if t.AccessToken == "" {
+		return ErrTokenMissing
+	}
+	t.SetAuthHeader(req)
+	return nil
+}
@@ -0,0 +1,132 @@
+package eventbrite
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/oauth2"
+)
+
+func TestAuthorization(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ClientOption
+		ctx  context.Context
+		want string
+	}{
+		{"token", []ClientOption{WithToken("client-token")}, context.Background(), "Bearer client-token"},
+		{"request token", []ClientOption{WithToken("client-token")},
+			WithRequestToken(context.Background(), "request-token"), "Bearer request-token"},
+		{"token source", []ClientOption{WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "source-token"}))},
+			context.Background(), "Bearer source-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got, query string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				got, query = r.Header.Get("Authorization"), r.URL.RawQuery
+				w.Write([]byte(`{}`))
+			}, tt.opts...)
+
+			if _, err := c.EventGet(tt.ctx, "1"); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got Authorization %q, want %q", got, tt.want)
+			}
+			if query != "" {
+				t.Errorf("got query %q, want the token out of the URL", query)
+			}
+		})
+	}
+}
+
+func TestAuthorizationMissingToken(t *testing.T) {
+	tests := []struct {
+		name        string
+		tokenSource oauth2.TokenSource
+	}{
+		{"no token", nil},
+		{"empty token", oauth2.StaticTokenSource(&oauth2.Token{})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				t.Error("request sent without a token")
+			})
+			c.tokenSource = tt.tokenSource
+
+			if _, err := c.EventGet(context.Background(), "1"); err != ErrTokenMissing {
+				t.Errorf("got error %v, want ErrTokenMissing", err)
+			}
+		})
+	}
+}
+
+// gateLimiter is a RateLimiter letting requests through once open is closed
+type gateLimiter struct {
+	open chan struct{}
+}
+
+func (l gateLimiter) Wait(ctx context.Context) error {
+	select {
+	case <-l.open:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (gateLimiter) Update(*http.Response) {}
+
+func (gateLimiter) Remaining() RateBudget { return RateBudget{} }
+
+// tokenSourceFunc adapts a function to an oauth2.TokenSource
+type tokenSourceFunc func() (*oauth2.Token, error)
+
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) { return f() }
+
+func TestAuthorizeAfterRateLimiter(t *testing.T) {
+	limiter := gateLimiter{open: make(chan struct{})}
+
+	var mu sync.Mutex
+	var opened, lookedUp bool
+	ts := tokenSourceFunc(func() (*oauth2.Token, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		lookedUp = true
+		if !opened {
+			t.Error("token looked up while waiting for the rate limiter")
+		}
+		return &oauth2.Token{AccessToken: "fresh", Expiry: time.Now().Add(time.Hour)}, nil
+	})
+
+	var got string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+		w.Write([]byte(`{}`))
+	}, WithTokenSource(ts), WithRateLimiter(limiter))
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		opened = true
+		mu.Unlock()
+		close(limiter.open)
+	}()
+
+	if _, err := c.EventGet(context.Background(), "1"); err != nil {
+		t.Fatal(err)
+	}
+	if !lookedUp {
+		t.Error("token never looked up")
+	}
+	if got != "Bearer fresh" {
+		t.Errorf("got Authorization %q, want %q", got, "Bearer fresh")
+	}
+}
@@ -0,0 +1,199 @@
+package eventbrite
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// encodeBody returns the JSON body of a POST request. The dotted json tags of the request
+// struct, such as "event.name.html", are expanded into nested objects:
+//
+//	{"event": {"name": {"html": "..."}}}
+//
+// Otherwise fields are encoded as by encoding/json, omitempty being honored, except that the
+// values whose IsZero method returns true, such as an unset DateTime, are always left out:
+// their zero encoding, e.g. "0001-01-01T00:00:00Z", would be taken as set by the API.
+func encodeBody(apiReq interface{}) ([]byte, error) {
+	if apiReq == nil {
+		return []byte("{}"), nil
+	}
+	v, err := encodeValue(reflect.ValueOf(apiReq))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// encodeValue returns v as a tree of maps and slices, whose leaves are encoded by encoding/json
+func encodeValue(v reflect.Value) (interface{}, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		if v.Kind() == reflect.Ptr && (v.Type().Implements(jsonMarshalerType) || v.Type().Implements(textMarshalerType)) {
+			break
+		}
+		v = v.Elem()
+	}
+
+	if v.Type().Implements(jsonMarshalerType) || v.Type().Implements(textMarshalerType) {
+		return marshalLeaf(v)
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		obj := map[string]interface{}{}
+		if err := encodeFields(obj, v); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if v.Type().Key().Kind() != reflect.String {
+			return marshalLeaf(v)
+		}
+		obj := map[string]interface{}{}
+		iter := v.MapRange()
+		for iter.Next() {
+			e, err := encodeValue(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			obj[iter.Key().String()] = e
+		}
+		return obj, nil
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && (v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8) {
+			return marshalLeaf(v)
+		}
+		list := make([]interface{}, v.Len())
+		for i := range list {
+			e, err := encodeValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			list[i] = e
+		}
+		return list, nil
+	}
+	return marshalLeaf(v)
+}
+
+// marshalLeaf encodes a value that is not expanded, such as a DateTime
+func marshalLeaf(v reflect.Value) (interface{}, error) {
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(data), nil
+}
+
+// encodeFields sets the fields of the struct in obj, at the path of their json tag
+func encodeFields(obj map[string]interface{}, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		fv := v.Field(i)
+		if field.Anonymous && name == "" {
+			// the fields of an embedded struct are promoted, as with encoding/json
+			for fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					break
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				if err := encodeFields(obj, fv); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if isUnset(fv) || (strings.Contains(","+opts+",", ",omitempty,") && isEmptyValue(fv)) {
+			continue
+		}
+
+		e, err := encodeValue(fv)
+		if err != nil {
+			return err
+		}
+		if err := setPath(obj, name, e); err != nil {
+			return fmt.Errorf("eventbrite: encoding %s.%s: %v", t.Name(), field.Name, err)
+		}
+	}
+	return nil
+}
+
+// setPath sets the value at the dotted path, creating the intermediate objects
+func setPath(obj map[string]interface{}, path string, value interface{}) error {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := obj[key]
+		if !ok {
+			next = map[string]interface{}{}
+			obj[key] = next
+		}
+		nested, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%q conflicts with the value of %q", path, key)
+		}
+		obj = nested
+	}
+
+	key := keys[len(keys)-1]
+	if _, ok := obj[key]; ok {
+		return fmt.Errorf("%q is set twice", path)
+	}
+	obj[key] = value
+	return nil
+}
+
+// isUnset reports whether v has an IsZero method returning true
+func isUnset(v reflect.Value) bool {
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return false
+	}
+	z, ok := v.Interface().(interface{ IsZero() bool })
+	return ok && z.IsZero()
+}
+
+// isEmptyValue reports whether v is empty for omitempty, as with encoding/json
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
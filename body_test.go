@@ -0,0 +1,156 @@
+package eventbrite
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEncodeBody(t *testing.T) {
+	type Embedded struct {
+		Extra string `json:"event.extra,omitempty"`
+	}
+	type request struct {
+		Embedded
+		Name     string                 `json:"event.name.html"`
+		Start    DateTime               `json:"event.start.utc"`
+		End      DateTime               `json:"event.end.utc,omitempty"`
+		Day      Date                   `json:"event.day"`
+		Cost     Currency               `json:"ticket_class.cost,omitempty"`
+		Free     bool                   `json:"ticket_class.free"`
+		Hidden   bool                   `json:"ticket_class.hidden,omitempty"`
+		Nil      *string                `json:"event.nil,omitempty"`
+		Ids      map[string]interface{} `json:"group.ids,omitempty"`
+		List     []string               `json:"list,omitempty"`
+		Skipped  string                 `json:"-"`
+		internal string
+	}
+	start := DateTime{Time: time.Date(2030, 1, 2, 18, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name string
+		req  interface{}
+		want string
+	}{
+		{"nil", nil, `{}`},
+		{"dotted names", &request{Name: "Gophercon"},
+			`{"event":{"name":{"html":"Gophercon"}},"ticket_class":{"free":false}}`},
+		{"value request", request{Name: "Gophercon", Free: true},
+			`{"event":{"name":{"html":"Gophercon"}},"ticket_class":{"free":true}}`},
+		{"dates", &request{Start: start, End: start, Day: Date{Time: start.Time}},
+			`{"event":{"day":"2030-01-02","end":{"utc":"2030-01-02T18:00:00Z"},"name":{"html":""},"start":{"utc":"2030-01-02T18:00:00Z"}},"ticket_class":{"free":false}}`},
+		{"currency", &request{Cost: Currency{Currency: "EUR", Value: 1000}},
+			`{"event":{"name":{"html":""}},"ticket_class":{"cost":{"currency":"EUR","value":1000},"free":false}}`},
+		{"embedded", &request{Embedded: Embedded{Extra: "x"}},
+			`{"event":{"extra":"x","name":{"html":""}},"ticket_class":{"free":false}}`},
+		{"map and list", &request{Ids: map[string]interface{}{"1.2": []string{"a"}}, List: []string{"b"}},
+			`{"event":{"name":{"html":""}},"group":{"ids":{"1.2":["a"]}},"list":["b"],"ticket_class":{"free":false}}`},
+		{"skipped", &request{Skipped: "x", internal: "y"},
+			`{"event":{"name":{"html":""}},"ticket_class":{"free":false}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := encodeBody(tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeBodyErrors(t *testing.T) {
+	type conflict struct {
+		Event string `json:"event"`
+		Name  string `json:"event.name"`
+	}
+	type twice struct {
+		Event map[string]string `json:"event"`
+		Name  string            `json:"event.name"`
+	}
+
+	tests := []struct {
+		name string
+		req  interface{}
+		want string
+	}{
+		{"conflict", conflict{}, `eventbrite: encoding conflict.Name: "event.name" conflicts with the value of "event"`},
+		{"set twice", twice{Event: map[string]string{"name": "x"}}, `eventbrite: encoding twice.Name: "event.name" is set twice`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := encodeBody(tt.req)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("got error %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeBodyOptionalFields(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+		want string
+	}{
+		{"ticket class name only", &EventUpdateTicketClass{Name: "VIP"},
+			`{"ticket_class":{"name":"VIP"}}`},
+		{"ticket class shown", &EventUpdateTicketClass{Hidden: Bool(false), QuantityTotal: Int(0)},
+			`{"ticket_class":{"hidden":false,"quantity_total":0}}`},
+		{"event unlisted", &EventUpdateRequest{Listed: Bool(false), Capacity: Int(0)},
+			`{"event":{"capacity":0,"currency":"","description":{"html":""},"end":{"timezone":"","utc":""},"listed":false,"organizer_id":"","start":{"timezone":"","utc":""}}}`},
+		{"display settings", &EventUpdateDisplaySettings{ShowMap: Bool(true)},
+			`{"display_settings":{"show_map":true}}`},
+		{"series", &SeriesCreateEventRequest{Name: "Meetup"},
+			`{"create_children":null,"series_parent":{"currency":"","end":{"timezone":""},"name":{"html":"Meetup"},"start":{"timezone":""}}}`},
+		{"unlimited discount", &DiscountUpdateRequest{Code: "EARLY", QuantityAvailable: Int(0)},
+			`{"discount":{"code":"EARLY","quantity_available":0}}`},
+		{"discount", &DiscountUpdateRequest{Code: "EARLY"},
+			`{"discount":{"code":"EARLY"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := encodeBody(tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetPath(t *testing.T) {
+	obj := map[string]interface{}{}
+	for _, path := range []string{"a.b.c", "a.b.d", "a.e", "f"} {
+		if err := setPath(obj, path, path); err != nil {
+			t.Fatalf("setPath(%s): %v", path, err)
+		}
+	}
+	a := obj["a"].(map[string]interface{})
+	b := a["b"].(map[string]interface{})
+	if b["c"] != "a.b.c" || b["d"] != "a.b.d" || a["e"] != "a.e" || obj["f"] != "f" {
+		t.Errorf("got %v", obj)
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"f.g", "conflicts"},
+		{"a.e.x", "conflicts"},
+		{"a.b", "set twice"},
+		{"a.b.c", "set twice"},
+	}
+	for _, tt := range tests {
+		if err := setPath(obj, tt.path, "x"); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("setPath(%s): got error %v, want it %s", tt.path, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+package eventbrite
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultCacheTTLs are the operations cached by a client configured with WithCache and no
+// TTLs: the reference data, which hardly ever changes
+var DefaultCacheTTLs = map[string]time.Duration{
+	"Categories":      24 * time.Hour,
+	"Category":        24 * time.Hour,
+	"SubCategories":   24 * time.Hour,
+	"SubCategory":     24 * time.Hour,
+	"Formats":         24 * time.Hour,
+	"Format":          24 * time.Hour,
+	"Timezones":       24 * time.Hour,
+	"Regions":         24 * time.Hour,
+	"Countries":       24 * time.Hour,
+	"CheckoutGetList": 24 * time.Hour,
+}
+
+// CacheEntry is a cached response
+type CacheEntry struct {
+	// The response body
+	Body []byte `json:"body"`
+	// The ETag of the response, sent back in If-None-Match to revalidate the entry
+	ETag string `json:"etag,omitempty"`
+	// The time the entry is fresh until. Expired entries are kept for revalidation.
+	Expires time.Time `json:"expires"`
+}
+
+// Cache stores the responses of a client. See NewLRUCache and NewDiskCache. Implementations
+// must be safe for concurrent use.
+type Cache interface {
+	// Get returns the entry stored under key
+	Get(key string) (CacheEntry, bool)
+	// Set stores the entry under key
+	Set(key string, e CacheEntry)
+	// DeletePrefix removes the entries whose key starts with prefix
+	DeletePrefix(prefix string)
+}
+
+// WithCache configures the client to cache the responses of GET requests in store. Only the
+// operations with a TTL are cached, keyed by the name of the client method, e.g.
+// {"VenueGet": time.Minute}; nil ttls caches the operations of DefaultCacheTTLs.
+//
+// Concurrent identical requests are sent once, on behalf of all their callers: a caller whose
+// context is done stops waiting without cancelling the request of the others. An expired entry
+// is revalidated with If-None-Match when the API sent an ETag for it. Successful POST and DELETE
+// requests invalidate the entries of the object they change, e.g. VenueUpdate those of the venue
+// and its events; use InvalidateCache for the listings of other objects.
+func WithCache(store Cache, ttls map[string]time.Duration) ClientOption {
+	return func(c *Client) error {
+		if ttls == nil {
+			ttls = DefaultCacheTTLs
+		}
+		c.cache = &responseCache{store: store, ttls: ttls}
+		return nil
+	}
+}
+
+type responseCache struct {
+	store   Cache
+	ttls    map[string]time.Duration
+	flights singleflight.Group
+}
+
+// InvalidateCache removes the cached responses of the path and of the paths below it, e.g.
+// "/venues/123" those of the venue and of its events
+func (c *Client) InvalidateCache(path string) {
+	if c.cache == nil {
+		return
+	}
+	path = strings.TrimSuffix(path, "/")
+	c.cache.store.DeletePrefix(path + "?")
+	c.cache.store.DeletePrefix(path + "/")
+}
+
+// invalidate removes the cached responses of the object changed by a request to path, i.e.
+// the object of the first two segments of the path
+func (c *Client) invalidate(path string) {
+	segments := strings.SplitN(strings.Trim(path, "/"), "/", 3)
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+	c.InvalidateCache("/" + strings.Join(segments, "/"))
+}
+
+// cacheTTL returns the TTL of the operation of the context, if it is cached
+func (c *Client) cacheTTL(ctx context.Context) (time.Duration, bool) {
+	if c.cache == nil {
+		return 0, false
+	}
+	ttl, ok := c.cache.ttls[OperationName(ctx)]
+	return ttl, ok && ttl > 0
+}
+
+type etagKey struct{}
+
+// cacheKey returns the key of a GET request. It starts with the path without its trailing
+// slash, so the entries of an object share a prefix, and ends with a hash of the token so
+// users never get each other's responses.
+func cacheKey(path string, query url.Values, token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return strings.TrimSuffix(path, "/") + "?" + query.Encode() + "#" + hex.EncodeToString(sum[:8])
+}
+
+// getCached returns the body of the response to a GET request, from the cache when it is fresh
+func (c *Client) getCached(ctx context.Context, path string, apiReq interface{}, ttl time.Duration) ([]byte, error) {
+	query, err := getQuery(ctx, apiReq)
+	if err != nil {
+		return nil, err
+	}
+	t, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := cacheKey(path, query, t.AccessToken)
+
+	span := trace.SpanFromContext(ctx)
+	entry, ok := c.cache.store.Get(key)
+	if ok && time.Now().Before(entry.Expires) {
+		span.SetAttributes(attribute.String("eventbrite.cache", "hit"))
+		c.logger.Debug("eventbrite: cache hit", "operation", OperationName(ctx), "path", path)
+		return entry.Body, nil
+	}
+	var stale *CacheEntry
+	if ok {
+		stale = &entry
+	}
+
+	// the request is sent once for all the callers waiting for it, in a span of its own and on
+	// a context none of them can cancel; each caller stops waiting once its context is done
+	flight := c.cache.flights.DoChan(key, func() (interface{}, error) {
+		fetchCtx, fetchSpan := c.tracer.Start(detachedContext{ctx}, "eventbrite.cacheFetch",
+			trace.WithNewRoot(), trace.WithLinks(trace.LinkFromContext(ctx)))
+		defer fetchSpan.End()
+
+		return c.fetchCached(fetchCtx, path, query, apiReq, key, ttl, stale)
+	})
+
+	var res singleflight.Result
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res = <-flight:
+	}
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	fetched := res.Val.(cachedResponse)
+	if res.Shared {
+		span.SetAttributes(attribute.String("eventbrite.cache", "shared"))
+	} else {
+		span.SetAttributes(attribute.String("eventbrite.cache", fetched.outcome))
+	}
+	return fetched.body, nil
+}
+
+// cachedResponse is the body of a response fetched for the cache, and whether it was a miss
+// or the revalidation of a stale entry
+type cachedResponse struct {
+	body    []byte
+	outcome string
+}
+
+// fetchCached sends a GET request and caches its response. A stale entry with an ETag is
+// revalidated, and kept when the API answers it was not modified.
+func (c *Client) fetchCached(ctx context.Context, path string, query url.Values, apiReq interface{}, key string,
+	ttl time.Duration, stale *CacheEntry) (cachedResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	if stale != nil && stale.ETag != "" {
+		ctx = context.WithValue(ctx, etagKey{}, stale.ETag)
+	}
+
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil)
+	if err != nil {
+		return cachedResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && stale != nil {
+		span.SetAttributes(attribute.String("eventbrite.cache", "revalidated"))
+		stale.Expires = time.Now().Add(ttl)
+		c.cache.store.Set(key, *stale)
+		return cachedResponse{body: stale.Body, outcome: "revalidated"}, nil
+	}
+	span.SetAttributes(attribute.String("eventbrite.cache", "miss"))
+
+	if err := checkResponse(resp, http.MethodGet, path, apiReq); err != nil {
+		return cachedResponse{}, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return cachedResponse{}, err
+	}
+	c.cache.store.Set(key, CacheEntry{Body: body, ETag: resp.Header.Get("ETag"), Expires: time.Now().Add(ttl)})
+
+	return cachedResponse{body: body, outcome: "miss"}, nil
+}
+
+// detachedContext carries the values of its parent, such as the operation name and the request
+// token, without its deadline and cancellation
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+func (detachedContext) Done() <-chan struct{} { return nil }
+
+func (detachedContext) Err() error { return nil }
+
+// lruCache is an in-memory Cache evicting the least recently used entries
+type lruCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List
+	items      map[string]*list.Element
+}
+
+type lruItem struct {
+	key   string
+	entry CacheEntry
+}
+
+// NewLRUCache returns an in-memory Cache holding up to maxEntries responses. The least
+// recently used entries are evicted first. A maxEntries of 0 or less means no limit.
+func NewLRUCache(maxEntries int) Cache {
+	return &lruCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		items:      map[string]*list.Element{},
+	}
+}
+
+func (l *lruCache) Get(key string) (CacheEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.items[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+	l.order.MoveToFront(el)
+	return el.Value.(*lruItem).entry, true
+}
+
+func (l *lruCache) Set(key string, e CacheEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.items[key]; ok {
+		el.Value.(*lruItem).entry = e
+		l.order.MoveToFront(el)
+		return
+	}
+	l.items[key] = l.order.PushFront(&lruItem{key: key, entry: e})
+	for l.maxEntries > 0 && l.order.Len() > l.maxEntries {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+func (l *lruCache) DeletePrefix(prefix string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key, el := range l.items {
+		if strings.HasPrefix(key, prefix) {
+			l.order.Remove(el)
+			delete(l.items, key)
+		}
+	}
+}
+
+// diskCache is a Cache keeping each entry in a file named after a hash of its key
+type diskCache struct {
+	dir string
+}
+
+type diskEntry struct {
+	Key string `json:"key"`
+	CacheEntry
+}
+
+// NewDiskCache returns a Cache storing its entries as files in dir, so they survive restarts
+// and are shared by the processes using the same directory. Failing to read or write an entry
+// is treated as a cache miss.
+func NewDiskCache(dir string) (Cache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &diskCache{dir: dir}, nil
+}
+
+func (d *diskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (d *diskCache) Get(key string) (CacheEntry, bool) {
+	e, err := readDiskEntry(d.path(key))
+	if err != nil || e.Key != key {
+		return CacheEntry{}, false
+	}
+	return e.CacheEntry, true
+}
+
+func (d *diskCache) Set(key string, e CacheEntry) {
+	data, err := json.Marshal(diskEntry{Key: key, CacheEntry: e})
+	if err != nil {
+		return
+	}
+	// write to a temporary file first so readers never see a partial entry
+	tmp, err := os.CreateTemp(d.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), d.path(key))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+func (d *diskCache) DeletePrefix(prefix string) {
+	files, err := filepath.Glob(filepath.Join(d.dir, "*.json"))
+	if err != nil {
+		return
+	}
+	for _, f := range files {
+		if e, err := readDiskEntry(f); err == nil && strings.HasPrefix(e.Key, prefix) {
+			os.Remove(f)
+		}
+	}
+}
+
+func readDiskEntry(path string) (diskEntry, error) {
+	var e diskEntry
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return e, err
+	}
+	return e, json.Unmarshal(data, &e)
+}
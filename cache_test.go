@@ -0,0 +1,239 @@
+package eventbrite
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// cacheStores are the Cache implementations every cache test runs against
+func cacheStores(t *testing.T) map[string]Cache {
+	disk, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]Cache{"lru": NewLRUCache(10), "disk": disk}
+}
+
+func TestCacheHitAndInvalidation(t *testing.T) {
+	for name, store := range cacheStores(t) {
+		t.Run(name, func(t *testing.T) {
+			var hits int32
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&hits, 1)
+				w.Write([]byte(`{"name": "Hall"}`))
+			}, WithCache(store, map[string]time.Duration{"VenueGet": time.Hour}))
+			ctx := context.Background()
+
+			steps := []struct {
+				name string
+				call func() error
+				hits int32
+			}{
+				{"miss", func() error { _, err := c.VenueGet(ctx, "7"); return err }, 1},
+				{"hit", func() error { _, err := c.VenueGet(ctx, "7"); return err }, 1},
+				{"other token", func() error { _, err := c.VenueGet(WithRequestToken(ctx, "other"), "7"); return err }, 2},
+				{"other venue", func() error { _, err := c.VenueGet(ctx, "8"); return err }, 3},
+				{"update", func() error { _, err := c.VenueUpdate(ctx, "7", &UpdateVenueRequest{Name: "Hall"}); return err }, 4},
+				{"invalidated by the update", func() error { _, err := c.VenueGet(ctx, "7"); return err }, 5},
+				{"other venue kept", func() error { _, err := c.VenueGet(ctx, "8"); return err }, 5},
+				{"invalidate", func() error { c.InvalidateCache("/venues/8/"); return nil }, 5},
+				{"invalidated", func() error { _, err := c.VenueGet(ctx, "8"); return err }, 6},
+			}
+			for _, s := range steps {
+				if err := s.call(); err != nil {
+					t.Fatalf("%s: %v", s.name, err)
+				}
+				if got := atomic.LoadInt32(&hits); got != s.hits {
+					t.Fatalf("%s: got %d requests, want %d", s.name, got, s.hits)
+				}
+			}
+		})
+	}
+}
+
+func TestCacheRevalidation(t *testing.T) {
+	var hits, notModified int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"formats": [{"id": "1", "name": "Seminar"}]}`))
+	}, WithCache(NewLRUCache(10), map[string]time.Duration{"Formats": time.Nanosecond}))
+
+	for i := 0; i < 3; i++ {
+		res, err := c.Formats(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Formats) != 1 {
+			t.Fatalf("got %d formats from attempt %d, want 1", len(res.Formats), i)
+		}
+	}
+	if hits != 3 || notModified != 2 {
+		t.Errorf("got %d requests of which %d not modified, want 3 and 2", hits, notModified)
+	}
+}
+
+func TestCacheSharedRequest(t *testing.T) {
+	var hits int32
+	release := make(chan struct{})
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+		w.Write([]byte(`{"categories": [{"id": "103", "name": "Music"}]}`))
+	}, WithCache(NewLRUCache(10), nil))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := c.Categories(context.Background())
+			if err != nil {
+				t.Error(err)
+			} else if len(res.Categories) != 1 {
+				t.Errorf("got %d categories, want 1", len(res.Categories))
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if hits != 1 {
+		t.Errorf("got %d requests, want 1", hits)
+	}
+}
+
+func TestCacheCancelledCaller(t *testing.T) {
+	var hits int32
+	received := make(chan struct{}, 1)
+	release := make(chan struct{})
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		received <- struct{}{}
+		<-release
+		w.Write([]byte(`{"categories": [{"id": "103", "name": "Music"}]}`))
+	}, WithCache(NewLRUCache(10), nil))
+
+	// the first caller gives up while the request it started is in flight
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := c.Categories(ctx)
+		first <- err
+	}()
+	<-received
+
+	second := make(chan error)
+	go func() {
+		_, err := c.Categories(context.Background())
+		second <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+	select {
+	case err := <-first:
+		if err != context.Canceled {
+			t.Errorf("got error %v for the cancelled caller, want context.Canceled", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("the cancelled caller is still waiting")
+	}
+
+	close(release)
+	if err := <-second; err != nil {
+		t.Errorf("got error %v for the other caller, want the shared response", err)
+	}
+	if hits != 1 {
+		t.Errorf("got %d requests, want 1", hits)
+	}
+}
+
+func TestDefaultCacheTTLsPaths(t *testing.T) {
+	var paths []string
+	var mu sync.Mutex
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		w.Write([]byte(`{}`))
+	}, WithCache(NewLRUCache(10), nil))
+	ctx := context.Background()
+
+	if _, err := c.Regions(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Countries(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(paths), "[/system/regions/ /system/countries/]"; got != want {
+		t.Errorf("got requests to %s, want %s", got, want)
+	}
+}
+
+func TestLRUCache(t *testing.T) {
+	tests := []struct {
+		maxEntries int
+		want       []string
+	}{
+		{2, []string{"c", "a"}},
+		{3, []string{"a", "b", "c"}},
+		{0, []string{"a", "b", "c"}},
+		{-1, []string{"a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		l := NewLRUCache(tt.maxEntries)
+		l.Set("a", CacheEntry{})
+		l.Set("b", CacheEntry{})
+		// a becomes the most recently used entry
+		l.Get("a")
+		l.Set("c", CacheEntry{})
+
+		var kept []string
+		for _, key := range []string{"a", "b", "c"} {
+			if _, ok := l.Get(key); ok {
+				kept = append(kept, key)
+			}
+		}
+		want := map[string]bool{}
+		for _, key := range tt.want {
+			want[key] = true
+		}
+		if len(kept) != len(want) {
+			t.Errorf("NewLRUCache(%d): kept %v, want %v", tt.maxEntries, kept, tt.want)
+			continue
+		}
+		for _, key := range kept {
+			if !want[key] {
+				t.Errorf("NewLRUCache(%d): kept %v, want %v", tt.maxEntries, kept, tt.want)
+			}
+		}
+	}
+}
+
+func TestLRUCacheDeletePrefix(t *testing.T) {
+	l := NewLRUCache(0)
+	for _, key := range []string{"/venues/7?", "/venues/7/events?", "/venues/8?"} {
+		l.Set(key, CacheEntry{})
+	}
+	l.DeletePrefix("/venues/7")
+
+	for key, want := range map[string]bool{"/venues/7?": false, "/venues/7/events?": false, "/venues/8?": true} {
+		if _, ok := l.Get(key); ok != want {
+			t.Errorf("entry %s kept: %t, want %t", key, ok, want)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import "golang.org/x/net/context"
 
 // CategoriesResult is the response structure for the Categories
 type CategoriesResult struct {
+	RawFields
+
 	Locale     string     `json:"locale"`
 	Pagination Pagination `json:"pagination"`
 	Categories []Category `json:"categories"`
@@ -11,6 +13,8 @@ type CategoriesResult struct {
 
 // SubCategoriesResult is the response structure for the SubCategories
 type SubCategoriesResult struct {
+	RawFields
+
 	Locale        string     `json:"locale"`
 	Pagination    Pagination `json:"pagination"`
 	Subcategories []Category `json:"subcategories"`
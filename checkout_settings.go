@@ -11,6 +11,7 @@ import (
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/checkout_settings/#ebapi-get-checkout-settings-countries-currencies
 type Checkout struct {
+	RawFields
 
 	// a list of supported ISO 3166-1 2-letter countries
 	Countries []string `json:"countries"`
@@ -19,7 +20,7 @@ type Checkout struct {
 	Currencies []string `json:"currencies"`
 
 	// a map of ISO 3166-1 alpha-2 country codes to their default ISO 4217 3-letter currency code
-	DefaultCurrenciesByCountry map[string]string `json:"currencies"`
+	DefaultCurrenciesByCountry map[string]string `json:"default_currencies_by_country"`
 }
 
 // CheckoutMethodsResponse is the response structure for the
@@ -27,6 +28,7 @@ type Checkout struct {
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/checkout_settings/#ebapi-get-checkout-settings-methods
 type CheckoutMethodsResponse struct {
+	RawFields
 
 	// a list with supported checkout methods given a country and currency combination.
 	// Set of possible values: [authnet, eventbrite, offline, paypal]
@@ -37,9 +39,30 @@ type CheckoutMethodsResponse struct {
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/checkout_settings/#ebapi-get-checkout-settings
 type CheckoutSettingsForAccount struct {
+	RawFields
+
+	CheckoutSettings []Checkout `json:"checkout_settings"`
+}
+
+// EventCheckoutSettings is the response structure of the Checkout settings of an event
+//
+// https://www.eventbrite.co.uk/developer/v3/endpoints/checkout_settings/#ebapi-get-events-event-id-checkout-settings
+type EventCheckoutSettings struct {
+	RawFields
+
 	CheckoutSettings []Checkout `json:"checkout_settings"`
 }
 
+// PayoutSettings is the response structure of the payout settings of an event
+//
+// https://www.eventbrite.co.uk/developer/v3/endpoints/checkout_settings/#ebapi-post-events-event-id-payout-settings
+type PayoutSettings struct {
+	RawFields
+
+	// The vault ID for the user instrument to which payouts are sent
+	UserInstrumentVaultID string `json:"user_instrument_vault_id"`
+}
+
 // CheckoutMethodsRequest is the request structure for the available
 // checkout methods to do payments given a country and a currency
 //
@@ -58,15 +81,15 @@ type CheckoutMethodsRequest struct {
 // https://www.eventbrite.co.uk/developer/v3/endpoints/checkout_settings/#ebapi-id3
 type CheckoutForAccountRequest struct {
 	// An optional country code by which to filter checkout settings
-	Country string `json:"country"`
+	Country string `json:"country,omitempty"`
 
 	// An optional currency code by which to filter checkout settings
-	Currency string `json:"currency"`
+	Currency string `json:"currency,omitempty"`
 
 	// One or more optional (comma-separated) checkout methods by which to filter checkout settings
-	CheckoutMethods string `json:"checkout_methods"`
+	CheckoutMethods string `json:"checkout_methods,omitempty"`
 
-	SearchMostRecentEvent bool `json:"search_most_recent_event"`
+	SearchMostRecentEvent bool `json:"search_most_recent_event,omitempty"`
 }
 
 // CheckoutCreateRequest is the request structure for creating a new Checkout settings
@@ -85,7 +108,7 @@ type CheckoutCreateRequest struct {
 	Method string `json:"checkout_settings.checkout_method" validate:"required"`
 
 	// The vault ID for the user instrument if the checkout method requires one
-	UserInstrumentVaultID string `json:"checkout_settings.user_instrument_vault_id"`
+	UserInstrumentVaultID string `json:"checkout_settings.user_instrument_vault_id,omitempty"`
 
 	// A list of additional settings for the offline checkout method, with each offline setting being in the
 	// format {"payment_method": "CASH"|"CHECK"|"INVOICE", "instructions": "Optional instructions"}. Required
@@ -103,12 +126,12 @@ type CheckoutCreateRequest struct {
 	//  ]
 	//
 	// also https://www.eventbrite.co.uk/developer/v3/response_formats/basic/#ebapi-std:format-objectlist
-	OfflineSettings interface{} `json:"checkout_settings.offline_settings"`
+	OfflineSettings interface{} `json:"checkout_settings.offline_settings,omitempty"`
 
 	// For the “paypal” checkout method, you can optionally specify a PayPal account email address instead
 	// of a user instrument vault ID, and a matching user instrument will be found or a new user instrument
 	// created with that email address and used to create the checkout settings.
-	PaypalEmail string `json:"paypal_email"`
+	PaypalEmail string `json:"paypal_email,omitempty"`
 }
 
 // CheckoutAssociateToEventRequest is the request structure to associate
@@ -118,7 +141,7 @@ type CheckoutCreateRequest struct {
 type CheckoutAssociateToEventRequest struct {
 
 	// A list of IDs for checkout settings that should be linked to the event. In the format: 1234,5678,9012
-	CheckoutSettingsIds []string `json:"checkout_settings_ids"`
+	CheckoutSettingsIds []string `json:"checkout_settings_ids,omitempty"`
 }
 
 // CheckoutAssociatePayoutToEvent is the request structure to associate a payout
@@ -147,7 +170,7 @@ func (c *Client) CheckoutGetList(ctx context.Context) (*Checkout, error) {
 func (c *Client) CheckoutMethods(ctx context.Context, req CheckoutMethodsRequest) (*CheckoutMethodsResponse, error) {
 	s := new(CheckoutMethodsResponse)
 
-	return s, c.getJSON(ctx, "/checkout_settings/methods/", nil, s)
+	return s, c.getJSON(ctx, "/checkout_settings/methods/", req, s)
 }
 
 // CheckoutForAccount searches and returns a list of checkout_settings for the current
@@ -157,7 +180,7 @@ func (c *Client) CheckoutMethods(ctx context.Context, req CheckoutMethodsRequest
 func (c *Client) CheckoutForAccount(ctx context.Context, req *CheckoutForAccountRequest) (*CheckoutSettingsForAccount, error) {
 	s := new(CheckoutSettingsForAccount)
 
-	return s, c.getJSON(ctx, "/checkout_settings/", nil, s)
+	return s, c.getJSON(ctx, "/checkout_settings/", req, s)
 }
 
 // CheckoutCreate creates a new checkout_settings object belonging to the current user. Two
@@ -182,14 +205,14 @@ func (c *Client) CheckoutCreate(ctx context.Context, req *CheckoutCreateRequest)
 func (c *Client) CheckoutGet(ctx context.Context, id string) (*Checkout, error) {
 	s := new(Checkout)
 
-	return s, c.getJSON(ctx, fmt.Sprintf("/checkout_settings/%d/", id), nil, s)
+	return s, c.getJSON(ctx, fmt.Sprintf("/checkout_settings/%s/", id), nil, s)
 }
 
 // CheckoutByEvent gets and returns a list of checkout_settings associated with a given event by its event_id
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/checkout_settings/#ebapi-get-events-event-id-checkout-settings
-func (c *Client) CheckoutByEvent(ctx context.Context, eventId string) ([]*Checkout, error) {
-	var s []*Checkout
+func (c *Client) CheckoutByEvent(ctx context.Context, eventId string) (*EventCheckoutSettings, error) {
+	s := new(EventCheckoutSettings)
 
 	return s, c.getJSON(ctx, fmt.Sprintf("/events/%s/checkout_settings/", eventId), nil, s)
 }
@@ -199,8 +222,8 @@ func (c *Client) CheckoutByEvent(ctx context.Context, eventId string) ([]*Checko
 // the one(s) submitted. The JSON post body is a string list of the checkout_settings IDs you want to associate
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/checkout_settings/#ebapi-post-events-event-id-checkout-settings
-func (c *Client) CheckoutAssociate(ctx context.Context, eventID string, req *CheckoutAssociateToEventRequest) (interface{}, error) {
-	var v interface{}
+func (c *Client) CheckoutAssociate(ctx context.Context, eventID string, req *CheckoutAssociateToEventRequest) (*EventCheckoutSettings, error) {
+	v := new(EventCheckoutSettings)
 
 	return v, c.postJSON(ctx, fmt.Sprintf("/events/%s/checkout_settings/", eventID), req, v)
 }
@@ -212,9 +235,8 @@ func (c *Client) CheckoutAssociate(ctx context.Context, eventID string, req *Che
 func (c *Client) CheckoutAssociatePayoutSettings(
 	ctx context.Context,
 	eventID string,
-	req *CheckoutAssociatePayoutToEvent) (interface{}, error) {
-	var v interface{}
-
-	return v, c.postJSON(ctx, fmt.Sprintf("/events/%s/checkout_settings/", eventID), req, v)
+	req *CheckoutAssociatePayoutToEvent) (*PayoutSettings, error) {
+	v := new(PayoutSettings)
 
+	return v, c.postJSON(ctx, fmt.Sprintf("/events/%s/payout_settings/", eventID), req, v)
 }
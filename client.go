@@ -3,32 +3,48 @@ package eventbrite
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
+	"io"
 	"net/http"
 	"net/url"
-	"reflect"
-	"strconv"
+	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/context"
-	"golang.org/x/net/context/ctxhttp"
+	"golang.org/x/oauth2"
 
 	"gopkg.in/go-playground/validator.v9"
-	"github.com/prometheus/common/log"
 )
 
 var (
-	defaultRequestsPerSecond = 5
-	validate                 = validator.New()
+	validate = validator.New()
 )
 
 // Client may be used to make requests to the Eventbrite API
 type Client struct {
-	httpClient        *http.Client
-	token             string
-	baseURL           string
-	requestsPerSecond int
-	ratePerSecond     chan int
+	httpClient  *http.Client
+	tokenSource oauth2.TokenSource
+	baseURL     string
+	rateLimits  RateLimits
+	limiter     RateLimiter
+	retryPolicy RetryPolicy
+	logger      Logger
+	middlewares []Middleware
+	metrics     Metrics
+	tracer      trace.Tracer
+	doer        Doer
+	cache       *responseCache
+	scheduler   *scheduler
+	dryRun      *DryRunPlan
+	policy      policy
+	done        chan struct{}
+	closeOnce   sync.Once
+
+	newTokenLimiter func(token string) RateLimiter
+	tokenLimitersMu sync.Mutex
+	tokenLimiters   map[string]*tokenLimiter
 }
 
 // ClientOption is the type of constructor options for NewClient(...).
@@ -36,11 +52,18 @@ type ClientOption func(*Client) error
 
 // NewClient constructs a new Client which can make requests to the Eventbrite API.
 func NewClient(options ...ClientOption) (*Client, error) {
-	c := &Client{}
+	c := &Client{
+		scheduler: newScheduler(),
+		done:      make(chan struct{}),
+	}
 
 	WithBaseURL("https://www.eventbriteapi.com/v3")(c)
-	WithRateLimit(defaultRequestsPerSecond)(c)
+	WithRateLimits(DefaultRateLimits)(c)
 	WithHTTPClient(&http.Client{})(c)
+	WithRetryPolicy(DefaultRetryPolicy)(c)
+	WithLogger(nil)(c)
+	WithMetrics(nil)(c)
+	WithTracerProvider(otel.GetTracerProvider())(c)
 
 	for _, option := range options {
 		err := option(c)
@@ -49,22 +72,24 @@ func NewClient(options ...ClientOption) (*Client, error) {
 		}
 	}
 
-	if c.requestsPerSecond > 0 {
-		c.ratePerSecond = make(chan int, c.requestsPerSecond)
-		for i := 0; i < c.requestsPerSecond; i++ {
-			c.ratePerSecond <- 1
-		}
-		go func() {
-			time.Sleep(time.Second)
-			for range time.Tick(time.Second / time.Duration(c.requestsPerSecond)) {
-				c.ratePerSecond <- 1
-			}
-		}()
+	if c.limiter == nil {
+		c.limiter = NewRateLimiter(c.rateLimits)
 	}
+	c.doer = c.chain()
 
 	return c, nil
 }
 
+// Close releases the resources held by the client. Requests waiting for the rate limiter
+// fail with ErrClientClosed, as do requests made afterwards. A rate limiter configured with
+// WithRateLimiter is left untouched for the other clients sharing it.
+func (c *Client) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+	return nil
+}
+
 // WithHTTPClient configures a Eventbrite client with a http.Client to make requests over.
 func WithHTTPClient(c *http.Client) ClientOption {
 	return func(client *Client) error {
@@ -73,14 +98,6 @@ func WithHTTPClient(c *http.Client) ClientOption {
 	}
 }
 
-// WithPersonalToken configures a Eventbrite API client with auth token
-func WithToken(token string) ClientOption {
-	return func(c *Client) error {
-		c.token = token
-		return nil
-	}
-}
-
 // WithBaseURL configures a Eventbrite API client with a custom base url
 func WithBaseURL(baseURL string) ClientOption {
 	return func(c *Client) error {
@@ -89,202 +106,265 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
-// WithRateLimit configures the rate limit for back end requests. Default is to
-// limit to 50 requests per second. A value of zero disables rate limiting.
+// WithRateLimit configures the per second rate limit for back end requests. Default is to
+// limit to 5 requests per second. A value of zero disables the per second limit, the hourly
+// and daily limits of WithRateLimits still apply.
 func WithRateLimit(requestsPerSecond int) ClientOption {
 	return func(c *Client) error {
-		c.requestsPerSecond = requestsPerSecond
+		c.rateLimits.PerSecond = requestsPerSecond
+		c.rateLimits.Burst = requestsPerSecond
 		return nil
 	}
 }
 
-func (c *Client) awaitRateLimiter(ctx context.Context) error {
-	if c.ratePerSecond == nil {
-		return nil
-	}
+// awaitRateLimiter queues the request by its priority and waits for the rate limiter
+func (c *Client) awaitRateLimiter(ctx context.Context, limiter RateLimiter, sched *scheduler) error {
 	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-c.ratePerSecond:
-		return nil
+	case <-c.done:
+		return ErrClientClosed
+	default:
 	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func(ctx context.Context) {
+		select {
+		case <-c.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}(ctx)
+
+	start := time.Now()
+	priority := priorityFrom(ctx)
+	ctx, span := c.tracer.Start(ctx, "eventbrite.awaitRateLimiter",
+		trace.WithAttributes(attribute.Int("eventbrite.priority", int(priority))))
+	defer span.End()
+
+	err := sched.acquire(ctx, priority)
+	if err == nil {
+		err = limiter.Wait(ctx)
+		sched.release()
+	}
+	if err != nil {
+		select {
+		case <-c.done:
+			err = ErrClientClosed
+		default:
+		}
+		traceError(ctx, err)
+		return err
+	}
+
+	wait := time.Since(start)
+	sched.observe(priority, wait)
+	c.metrics.ObserveRateLimitWait(OperationName(ctx), wait)
+	return nil
 }
 
 func (c *Client) get(ctx context.Context, path string, apiReq interface{}) (*http.Response, error) {
-	if err := c.awaitRateLimiter(ctx); err != nil {
+	query, err := getQuery(ctx, apiReq)
+	if err != nil {
 		return nil, err
 	}
 
-	if apiReq != nil {
+	return c.do(ctx, http.MethodGet, path, query, nil)
+}
+
+// getQuery validates the request and returns the query parameters of a GET request
+func getQuery(ctx context.Context, apiReq interface{}) (url.Values, error) {
+	// a url.Values is sent as is
+	if _, ok := apiReq.(url.Values); !ok && !isNilRequest(apiReq) {
 		if err := validate.Struct(apiReq); err != nil {
 			return nil, err
 		}
 	}
 
-	host := path
-	if c.baseURL != "" {
-		host = c.baseURL
-	}
-	req, err := http.NewRequest(http.MethodGet, host+path, nil)
-	if err != nil {
-		return nil, err
-	}
-	q, err := c.generateAuthQuery(path, toValues(apiReq))
-	if err != nil {
-		return nil, err
-	}
-	req.URL.RawQuery = q
-
-	// todo : remove me
-	log.Info(req.URL.String())
+	query := encodeQuery(apiReq)
+	setPageParams(ctx, query)
+	setExpansions(ctx, query)
 
-	return ctxhttp.Do(ctx, c.httpClient, req)
+	return query, nil
 }
 
 func (c *Client) delete(ctx context.Context, path string) (*http.Response, error) {
-	if err := c.awaitRateLimiter(ctx); err != nil {
-		return nil, err
-	}
-
-	host := path
-	if c.baseURL != "" {
-		host = c.baseURL
-	}
-
-	req, err := http.NewRequest(http.MethodDelete, host+path, nil)
-	if err != nil {
-		return nil, err
-	}
+	return c.do(ctx, http.MethodDelete, path, url.Values{}, nil)
+}
 
-	q, err := c.generateAuthQuery(path, url.Values{})
+func (c *Client) post(ctx context.Context, path string, apiReq interface{}) (*http.Response, error) {
+	body, err := postBody(apiReq)
 	if err != nil {
 		return nil, err
 	}
 
-	req.URL.RawQuery = q
-	return ctxhttp.Do(ctx, c.httpClient, req)
+	return c.do(ctx, http.MethodPost, path, url.Values{}, body)
 }
 
-func (c *Client) post(ctx context.Context, path string, apiReq interface{}) (*http.Response, error) {
-
+// postBody validates the request and returns the body of a POST request
+func postBody(apiReq interface{}) ([]byte, error) {
 	if apiReq != nil {
 		if err := validate.Struct(apiReq); err != nil {
 			return nil, err
 		}
 	}
 
-	if err := c.awaitRateLimiter(ctx); err != nil {
-		return nil, err
-	}
+	return encodeBody(apiReq)
+}
 
-	host := path
-	if c.baseURL != "" {
-		host = c.baseURL
-	}
+// do sends the request, retrying it according to the client retry policy. The returned
+// response is the one of the last attempt
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body []byte) (*http.Response, error) {
+	start := time.Now()
+	limiter, sched := c.limiterFor(ctx)
+	for attempt := 1; ; attempt++ {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(body)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+		if err != nil {
+			return nil, err
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		req.URL.RawQuery = query.Encode()
+		if etag, ok := ctx.Value(etagKey{}).(string); ok {
+			req.Header.Set("If-None-Match", etag)
+		}
 
-	body, err := json.Marshal(apiReq)
-	if err != nil {
-		return nil, err
+		if err := c.awaitRateLimiter(ctx, limiter, sched); err != nil {
+			return nil, err
+		}
+
+		// the token is looked up on every attempt, after the wait, so one expiring while the
+		// request waits for the rate limiter or before a retry is refreshed
+		if err := c.authorize(ctx, req); err != nil {
+			return nil, err
+		}
+
+		sent := time.Now()
+		resp, err := c.doer.Do(req)
+		limiter.Update(resp)
+		traceAttempt(ctx, attempt, resp)
+		c.logResponse(ctx, method, path, query, attempt, time.Since(sent), resp, err)
+
+		wait, retry := c.retryPolicy.next(ctx, method, attempt, time.Since(start), resp, err)
+		if !retry {
+			return resp, err
+		}
+		c.metrics.ObserveRetry(OperationName(ctx))
+		c.logger.Info("eventbrite: retrying request", "operation", OperationName(ctx), "method", method, "path", path,
+			"attempt", attempt, "wait", wait)
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
 	}
-	req, err := http.NewRequest(http.MethodPost, host+path, bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
+}
+
+// logResponse logs and records the outcome of one attempt
+func (c *Client) logResponse(ctx context.Context, method, path string, query url.Values, attempt int,
+	latency time.Duration, resp *http.Response, err error) {
+	keyvals := []interface{}{
+		"operation", OperationName(ctx), "method", method, "path", path, "query", query,
+		"attempt", attempt, "latency", latency,
 	}
-	req.Header.Set("Content-Type", "application/json")
-	q, err := c.generateAuthQuery(path, url.Values{})
 	if err != nil {
-		return nil, err
+		c.metrics.ObserveRequest(OperationName(ctx), method, 0, latency)
+		c.logger.Debug("eventbrite: request failed", append(keyvals, "error", err)...)
+		return
 	}
-
-	req.URL.RawQuery = q
-	return ctxhttp.Do(ctx, c.httpClient, req)
+	c.metrics.ObserveRequest(OperationName(ctx), method, resp.StatusCode, latency)
+	c.logger.Debug("eventbrite: response", append(keyvals, "status", resp.StatusCode)...)
 }
 
-func (c *Client) generateAuthQuery(path string, q url.Values) (string, error) {
-	if c.token != "" {
-		q.Set("token", c.token)
-		// todo : we should look to make expand dynamic
-		q.Set("expand", "venue,category,subcategories,event,attendees,refund_requests,survey,answers")
-		return q.Encode(), nil
+func (c *Client) getJSON(ctx context.Context, path string, apiReq interface{}, resp interface{}) error {
+	ctx = withOperation(ctx)
+	ctx, span := c.startSpan(ctx, http.MethodGet, path)
+	defer span.End()
+
+	if err := c.permit(ctx, http.MethodGet, path); err != nil {
+		return c.observeError(ctx, err)
+	}
+
+	if ttl, ok := c.cacheTTL(ctx); ok {
+		body, err := c.getCached(ctx, path, apiReq, ttl)
+		if err != nil {
+			return c.observeError(ctx, err)
+		}
+		return json.Unmarshal(body, resp)
 	}
-	return "", errors.New("eventbrite: Token missing")
-}
 
-func (c *Client) getJSON(ctx context.Context, path string, apiReq interface{}, resp interface{}) error {
 	httpResp, err := c.get(ctx, path, apiReq)
 	if err != nil {
-		return err
+		return c.observeError(ctx, err)
 	}
 	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode == 200 {
-		return json.NewDecoder(httpResp.Body).Decode(resp)
+	if err := checkResponse(httpResp, http.MethodGet, path, apiReq); err != nil {
+		return c.observeError(ctx, err)
 	}
 
-	respErr := Error{}
-	json.NewDecoder(httpResp.Body).Decode(&respErr)
-	return respErr
+	return json.NewDecoder(httpResp.Body).Decode(resp)
 }
 
 func (c *Client) postJSON(ctx context.Context, path string, apiReq interface{}, resp interface{}) error {
-	httpResp, err := c.post(ctx, path, apiReq)
-	if err != nil {
-		return err
+	ctx = withOperation(ctx)
+	ctx, span := c.startSpan(ctx, http.MethodPost, path)
+	defer span.End()
+
+	if err := c.permit(ctx, http.MethodPost, path); err != nil {
+		return c.observeError(ctx, err)
 	}
-	defer httpResp.Body.Close()
 
-	return json.NewDecoder(httpResp.Body).Decode(resp)
-}
+	if c.dryRun != nil {
+		return c.observeError(ctx, c.plan(ctx, http.MethodPost, path, apiReq, resp))
+	}
 
-func (c *Client) deleteJSON(ctx context.Context, path string, resp interface{}) error {
-	httpResp, err := c.delete(ctx, path)
+	httpResp, err := c.post(ctx, path, apiReq)
 	if err != nil {
-		return err
+		return c.observeError(ctx, err)
 	}
 	defer httpResp.Body.Close()
 
+	if err := checkResponse(httpResp, http.MethodPost, path, apiReq); err != nil {
+		return c.observeError(ctx, err)
+	}
+	c.invalidate(path)
+
 	return json.NewDecoder(httpResp.Body).Decode(resp)
 }
 
-func toValues(i interface{}) (values url.Values) {
+func (c *Client) deleteJSON(ctx context.Context, path string, resp interface{}) error {
+	ctx = withOperation(ctx)
+	ctx, span := c.startSpan(ctx, http.MethodDelete, path)
+	defer span.End()
 
-	if i == nil {
-		return url.Values{}
+	if err := c.permit(ctx, http.MethodDelete, path); err != nil {
+		return c.observeError(ctx, err)
 	}
 
-	switch i.(type) {
-	case url.Values:
-		return i.(url.Values)
+	if c.dryRun != nil {
+		return c.observeError(ctx, c.plan(ctx, http.MethodDelete, path, nil, resp))
 	}
 
-	values = url.Values{}
-	iVal := reflect.ValueOf(i).Elem()
-	typ := iVal.Type()
-	for i := 0; i < iVal.NumField(); i++ {
-		f := iVal.Field(i)
-		tag := typ.Field(i).Tag.Get("json")
-		if tag == "" {
-			tag = typ.Field(i).Name
-		}
-		var v string
-		switch f.Interface().(type) {
-		case int, int8, int16, int32, int64:
-			v = strconv.FormatInt(f.Int(), 10)
-		case uint, uint8, uint16, uint32, uint64:
-			v = strconv.FormatUint(f.Uint(), 10)
-		case float32:
-			v = strconv.FormatFloat(f.Float(), 'f', 4, 32)
-		case float64:
-			v = strconv.FormatFloat(f.Float(), 'f', 4, 64)
-		case []byte:
-			v = string(f.Bytes())
-		case string:
-			v = f.String()
-		}
+	httpResp, err := c.delete(ctx, path)
+	if err != nil {
+		return c.observeError(ctx, err)
+	}
+	defer httpResp.Body.Close()
 
-		if v != "" {
-			values.Set(tag, v)
-		}
+	if err := checkResponse(httpResp, http.MethodDelete, path, nil); err != nil {
+		return c.observeError(ctx, err)
 	}
-	return
+	c.invalidate(path)
+
+	return json.NewDecoder(httpResp.Body).Decode(resp)
 }
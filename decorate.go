@@ -0,0 +1,129 @@
+package eventbrite
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Call is a call of an operation of a service wrapped with Decorate
+type Call struct {
+	// The name of the operation, i.e. of the method, e.g. "EventGet"
+	Operation string
+	// The arguments of the method after the context
+	Args []interface{}
+	// Whether the operation changes data, i.e. is sent with POST or DELETE
+	Mutating bool
+
+	newResult func() interface{}
+}
+
+// NewResult returns a new empty result of the operation, e.g. a *Event for EventGet
+func (c *Call) NewResult() interface{} {
+	return c.newResult()
+}
+
+// Interceptor runs around the calls of a decorated service. It calls next to continue with the
+// next interceptor and eventually the wrapped service, or returns a result of the type of
+// Call.NewResult without calling it.
+type Interceptor func(ctx context.Context, call *Call, next func(ctx context.Context) (interface{}, error)) (interface{}, error)
+
+// decorator runs the interceptors of a decorated service, the first one being the outermost
+type decorator struct {
+	interceptors []Interceptor
+}
+
+func (d decorator) intercept(ctx context.Context, call *Call, next func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	for i := len(d.interceptors) - 1; i >= 0; i-- {
+		interceptor, n := d.interceptors[i], next
+		next = func(ctx context.Context) (interface{}, error) {
+			return interceptor(ctx, call, n)
+		}
+	}
+	return next(ctx)
+}
+
+// decoratedResult returns the result of a decorated call with its type, or an error when an
+// interceptor returned a result of another type, or none, without an error
+func decoratedResult[T any](call *Call, res interface{}, err error) (*T, error) {
+	if r, ok := res.(*T); ok || err != nil {
+		return r, err
+	}
+	return nil, fmt.Errorf("eventbrite: an interceptor of %s returned a %T, want a %T", call.Operation, res, (*T)(nil))
+}
+
+// LoggingInterceptor logs the calls with their duration to l, at the debug level, or at the
+// error level when they fail. The arguments are not logged as they may hold personal data.
+func LoggingInterceptor(l Logger) Interceptor {
+	l = newScrubLogger(l)
+	return func(ctx context.Context, call *Call, next func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+		start := time.Now()
+		res, err := next(ctx)
+		if err != nil {
+			l.Error("eventbrite: call failed", "operation", call.Operation, "duration", time.Since(start), "error", err)
+		} else {
+			l.Debug("eventbrite: call", "operation", call.Operation, "duration", time.Since(start))
+		}
+		return res, err
+	}
+}
+
+// CachingInterceptor caches the results of the operations with a TTL in store, keyed by the
+// operation and its arguments; nil ttls caches the operations of DefaultCacheTTLs. The store
+// holds the results of a single service, and is cleared by every successful mutating call
+// as the interceptor cannot tell which results they change.
+//
+// A Client is better configured with WithCache, which revalidates its entries with ETags.
+func CachingInterceptor(store Cache, ttls map[string]time.Duration) Interceptor {
+	if ttls == nil {
+		ttls = DefaultCacheTTLs
+	}
+	return func(ctx context.Context, call *Call, next func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+		if call.Mutating {
+			res, err := next(ctx)
+			if err == nil {
+				store.DeletePrefix("")
+			}
+			return res, err
+		}
+
+		ttl := ttls[call.Operation]
+		args, err := json.Marshal(call.Args)
+		if ttl <= 0 || err != nil {
+			return next(ctx)
+		}
+		key := call.Operation + "?" + string(args)
+		if e, ok := store.Get(key); ok && time.Now().Before(e.Expires) {
+			res := call.NewResult()
+			if err := json.Unmarshal(e.Body, res); err == nil {
+				return res, nil
+			}
+		}
+
+		res, err := next(ctx)
+		if err != nil {
+			return res, err
+		}
+		if body, err := json.Marshal(res); err == nil {
+			store.Set(key, CacheEntry{Body: body, Expires: time.Now().Add(ttl)})
+		}
+		return res, nil
+	}
+}
+
+// DryRunInterceptor skips the mutating calls: they are logged to l at the info level, if not
+// nil, and return an empty result, while the other calls go through.
+//
+// A Client is better configured with WithDryRun, which validates and records the requests.
+func DryRunInterceptor(l Logger) Interceptor {
+	l = newScrubLogger(l)
+	return func(ctx context.Context, call *Call, next func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+		if !call.Mutating {
+			return next(ctx)
+		}
+		l.Info("eventbrite: dry run, call skipped", "operation", call.Operation)
+		return call.NewResult(), nil
+	}
+}
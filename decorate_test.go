@@ -0,0 +1,212 @@
+package eventbrite_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/apzuk/go-eventbrite"
+	"github.com/apzuk/go-eventbrite/eventbritetest"
+)
+
+// venueMock returns a Mock whose VenueGet and VenueUpdate return a venue named after the call
+// count, which is stored in calls
+func venueMock(calls *int) *eventbritetest.Mock {
+	venue := func() (*eventbrite.Venue, error) {
+		*calls++
+		return &eventbrite.Venue{Name: fmt.Sprint("venue ", *calls)}, nil
+	}
+	return &eventbritetest.Mock{
+		VenueGetFunc: func(ctx context.Context, id string) (*eventbrite.Venue, error) {
+			return venue()
+		},
+		VenueUpdateFunc: func(ctx context.Context, id string, req *eventbrite.UpdateVenueRequest) (*eventbrite.Venue, error) {
+			return venue()
+		},
+	}
+}
+
+func TestDecorate(t *testing.T) {
+	var trace []string
+	var calls []eventbrite.Call
+	tracing := func(name string) eventbrite.Interceptor {
+		return func(ctx context.Context, call *eventbrite.Call, next func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+			trace = append(trace, ">"+name)
+			calls = append(calls, *call)
+			res, err := next(ctx)
+			trace = append(trace, "<"+name)
+			return res, err
+		}
+	}
+	var n int
+	s := eventbrite.Decorate(venueMock(&n), tracing("a"), tracing("b"))
+	ctx := context.Background()
+
+	venue, err := s.VenueGet(ctx, "7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if venue.Name != "venue 1" {
+		t.Errorf("got venue %q, want the one of the service", venue.Name)
+	}
+	if got, want := strings.Join(trace, " "), ">a >b <b <a"; got != want {
+		t.Errorf("got interceptors run as %s, want %s", got, want)
+	}
+
+	req := &eventbrite.UpdateVenueRequest{Name: "Hall"}
+	if _, err := s.VenueUpdate(ctx, "7", req); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		call      eventbrite.Call
+		operation string
+		args      []interface{}
+		mutating  bool
+		result    interface{}
+	}{
+		{calls[0], "VenueGet", []interface{}{"7"}, false, &eventbrite.Venue{}},
+		{calls[2], "VenueUpdate", []interface{}{"7", req}, true, &eventbrite.Venue{}},
+	}
+	for _, tt := range tests {
+		if tt.call.Operation != tt.operation || !reflect.DeepEqual(tt.call.Args, tt.args) || tt.call.Mutating != tt.mutating {
+			t.Errorf("got call %+v, want %s%v mutating %t", tt.call, tt.operation, tt.args, tt.mutating)
+		}
+		if got := tt.call.NewResult(); !reflect.DeepEqual(got, tt.result) {
+			t.Errorf("%s: got new result %#v, want %#v", tt.operation, got, tt.result)
+		}
+	}
+}
+
+func TestDecorateResult(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	tests := []struct {
+		name     string
+		res      interface{}
+		err      error
+		wantName string
+		wantErr  string
+	}{
+		{"result", &eventbrite.Venue{Name: "Hall"}, nil, "Hall", ""},
+		{"result with an error", &eventbrite.Venue{Name: "Hall"}, errFailed, "Hall", "failed"},
+		{"typed nil with an error", (*eventbrite.Venue)(nil), errFailed, "", "failed"},
+		{"error", nil, errFailed, "", "failed"},
+		{"wrong type with an error", &eventbrite.Event{}, errFailed, "", "failed"},
+		{"wrong type", &eventbrite.Event{}, nil, "",
+			"eventbrite: an interceptor of VenueGet returned a *eventbrite.Event, want a *eventbrite.Venue"},
+		{"no result", nil, nil, "", "eventbrite: an interceptor of VenueGet returned a <nil>, want a *eventbrite.Venue"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := eventbrite.DecorateVenueService(&eventbritetest.Mock{},
+				func(ctx context.Context, call *eventbrite.Call, next func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+					return tt.res, tt.err
+				})
+
+			venue, err := s.VenueGet(context.Background(), "7")
+			if gotErr := fmt.Sprint(err); (err != nil || tt.wantErr != "") && gotErr != tt.wantErr {
+				t.Errorf("got error %s, want %s", gotErr, tt.wantErr)
+			}
+			if gotName := ""; venue != nil {
+				if gotName = venue.Name; gotName != tt.wantName {
+					t.Errorf("got venue %q, want %q", gotName, tt.wantName)
+				}
+			} else if tt.wantName != "" {
+				t.Errorf("got no venue, want %q", tt.wantName)
+			}
+		})
+	}
+}
+
+func TestCachingInterceptor(t *testing.T) {
+	var n int
+	s := eventbrite.DecorateVenueService(venueMock(&n),
+		eventbrite.CachingInterceptor(eventbrite.NewLRUCache(10), map[string]time.Duration{"VenueGet": time.Hour}))
+	ctx := context.Background()
+
+	steps := []struct {
+		name  string
+		call  func() (*eventbrite.Venue, error)
+		venue string
+	}{
+		{"miss", func() (*eventbrite.Venue, error) { return s.VenueGet(ctx, "7") }, "venue 1"},
+		{"hit", func() (*eventbrite.Venue, error) { return s.VenueGet(ctx, "7") }, "venue 1"},
+		{"other arguments", func() (*eventbrite.Venue, error) { return s.VenueGet(ctx, "8") }, "venue 2"},
+		{"mutating", func() (*eventbrite.Venue, error) { return s.VenueUpdate(ctx, "7", nil) }, "venue 3"},
+		{"cleared by the mutating call", func() (*eventbrite.Venue, error) { return s.VenueGet(ctx, "7") }, "venue 4"},
+	}
+	for _, step := range steps {
+		venue, err := step.call()
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if venue.Name != step.venue {
+			t.Errorf("%s: got %q, want %q", step.name, venue.Name, step.venue)
+		}
+	}
+}
+
+func TestDryRunInterceptor(t *testing.T) {
+	var n int
+	s := eventbrite.DecorateVenueService(venueMock(&n), eventbrite.DryRunInterceptor(nil))
+	ctx := context.Background()
+
+	venue, err := s.VenueUpdate(ctx, "7", &eventbrite.UpdateVenueRequest{Name: "Hall"})
+	if err != nil || venue == nil || venue.Name != "" || n != 0 {
+		t.Errorf("got venue %+v and error %v after %d calls, want an empty venue without calls", venue, err, n)
+	}
+	venue, err = s.VenueGet(ctx, "7")
+	if err != nil || venue == nil || venue.Name != "venue 1" {
+		t.Errorf("got venue %+v and error %v, want the venue of the service", venue, err)
+	}
+}
+
+// recordLogger keeps the messages logged at each level
+type recordLogger map[string][]string
+
+func (l recordLogger) log(level, msg string, keyvals []interface{}) {
+	l[level] = append(l[level], fmt.Sprint(append([]interface{}{msg}, keyvals...)...))
+}
+
+func (l recordLogger) Debug(msg string, keyvals ...interface{}) { l.log("debug", msg, keyvals) }
+func (l recordLogger) Info(msg string, keyvals ...interface{})  { l.log("info", msg, keyvals) }
+func (l recordLogger) Error(msg string, keyvals ...interface{}) { l.log("error", msg, keyvals) }
+
+func TestLoggingInterceptor(t *testing.T) {
+	l := recordLogger{}
+	s := eventbrite.DecorateVenueService(&eventbritetest.Mock{
+		VenueGetFunc: func(ctx context.Context, id string) (*eventbrite.Venue, error) {
+			return &eventbrite.Venue{}, nil
+		},
+	}, eventbrite.LoggingInterceptor(l))
+	ctx := context.Background()
+
+	s.VenueGet(ctx, "secret-id")
+	s.VenueCreate(ctx, nil)
+
+	tests := []struct {
+		level     string
+		operation string
+	}{
+		{"debug", "VenueGet"},
+		{"error", "VenueCreate"},
+	}
+	for _, tt := range tests {
+		if len(l[tt.level]) != 1 || !strings.Contains(l[tt.level][0], tt.operation) {
+			t.Errorf("got %s messages %q, want one for %s", tt.level, l[tt.level], tt.operation)
+		}
+	}
+	for _, msgs := range l {
+		for _, msg := range msgs {
+			if strings.Contains(msg, "secret-id") {
+				t.Errorf("the arguments were logged: %s", msg)
+			}
+		}
+	}
+}
@@ -18,6 +18,8 @@ import (
 //
 // https://www.eventbrite.co.uk/developer/v3/response_formats/event/#ebapi-std:format-cross_event_discount
 type CrossEventDiscount struct {
+	RawFields
+
 	// The name of the discount (on public discounts) or the code that
 	// user should provide in order to activate it (on access codes or coded discounts)
 	Code string `json:"code"`
@@ -64,32 +66,32 @@ type DiscountCreateRequest struct {
 	// Code used to activate discount
 	Code string `json:"discount.code" validate:"required"`
 	// One of access, coded, public or hold, indicating the type of discount
-	Type string `json:"discount.type"`
+	Type string `json:"discount.type,omitempty"`
 	// Fixed reduction amount
-	AmountOff float64 `json:"discount.amount_off"`
+	AmountOff float64 `json:"discount.amount_off,omitempty"`
 	// A percentage discount that will be applied on the ticket display price during the checkout,
 	// from 1.00 to 100.00. Only two decimals are allowed. Will be null for an access code
-	PercentOff float64 `json:"discount.percent_off"`
-	// Number of discount uses
-	QuantityAvailable int `json:"discount.quantity_available"`
+	PercentOff float64 `json:"discount.percent_off,omitempty"`
+	// Number of discount uses, 0 for unlimited
+	QuantityAvailable *int `json:"discount.quantity_available,omitempty"`
 	// Allow use from this date. A datetime represented as a string in Naive Local
 	// ISO8601 date and time format, in the timezone of the event
-	StartDate DateTime `json:"start_date"`
+	StartDate DateTime `json:"discount.start_date,omitempty"`
 	// Allow use from this number of seconds before the event starts. Greater than 59 and multiple of 60
-	StartDateRelative int `json:"discount.start_date_relative"`
+	StartDateRelative int `json:"discount.start_date_relative,omitempty"`
 	// Allow use until this date. A datetime represented as a string in Naive Local ISO8601 date
 	// and time format, in the timezone of the event
-	EndDate DateTime `json:"discount.end_date"`
+	EndDate DateTime `json:"discount.end_date,omitempty"`
 	// Allow use until this number of seconds before the event starts. Greater than 59 and multiple of 60
-	EndDateRelative int `json:"discount.end_date_relative"`
+	EndDateRelative int `json:"discount.end_date_relative,omitempty"`
 	// IDs of tickets to limit discount to
-	TicketClassIds []string `json:"discount.ticket_class_ids"`
+	TicketClassIds []string `json:"discount.ticket_class_ids,omitempty"`
 	// ID of the event. Only used for single event discounts
-	EventID string `json:"discount.event_id"`
+	EventID string `json:"discount.event_id,omitempty"`
 	// ID of the ticket group
-	TicketGroupID string `json:"discount.ticket_group_id"`
+	TicketGroupID string `json:"discount.ticket_group_id,omitempty"`
 	// IDs of holds this discount can unlock
-	HoldIds []string `json:"discount.old_ids"`
+	HoldIds []string `json:"discount.hold_ids,omitempty"`
 }
 
 // DiscountUpdateRequest is the structure to update a CrossEventDiscount
@@ -99,26 +101,26 @@ type DiscountUpdateRequest struct {
 	// Code used to activate discount
 	Code string `json:"discount.code" validate:"required"`
 	// Fixed reduction amount
-	AmountOff float64 `json:"discount.amount_off"`
+	AmountOff float64 `json:"discount.amount_off,omitempty"`
 	// A percentage discount that will be applied on the ticket display price during the checkout,
 	// from 1.00 to 100.00. Only two decimals are allowed. Will be null for an access code
-	PercentOff float64 `json:"discount.percent_off"`
-	// Number of discount uses
-	QuantityAvailable int `json:"discount.quantity_available"`
+	PercentOff float64 `json:"discount.percent_off,omitempty"`
+	// Number of discount uses, 0 for unlimited
+	QuantityAvailable *int `json:"discount.quantity_available,omitempty"`
 	// Allow use from this date. A datetime represented as a string in Naive Local
 	// ISO8601 date and time format, in the timezone of the event
-	StartDate DateTime `json:"start_date"`
+	StartDate DateTime `json:"discount.start_date,omitempty"`
 	// Allow use from this number of seconds before the event starts. Greater than 59 and multiple of 60
-	StartDateRelative int `json:"discount.start_date_relative"`
+	StartDateRelative int `json:"discount.start_date_relative,omitempty"`
 	// Allow use until this date. A datetime represented as a string in Naive Local ISO8601 date
 	// and time format, in the timezone of the event
-	EndDate DateTime `json:"discount.end_date"`
+	EndDate DateTime `json:"discount.end_date,omitempty"`
 	// Allow use until this number of seconds before the event starts. Greater than 59 and multiple of 60
-	EndDateRelative int `json:"discount.end_date_relative"`
+	EndDateRelative int `json:"discount.end_date_relative,omitempty"`
 	// IDs of tickets to limit discount to
-	TicketClassIds []string `json:"discount.ticket_class_ids"`
+	TicketClassIds []string `json:"discount.ticket_class_ids,omitempty"`
 	// IDs of holds this discount can unlock
-	HoldIds []string `json:"discount.hold_ids"`
+	HoldIds []string `json:"discount.hold_ids,omitempty"`
 }
 
 // DiscountsGet returns the cross_event_discount with the specified :discount_id
@@ -174,8 +176,8 @@ func (c *Client) DiscountUpdate(ctx context.Context, id string, req *DiscountUpd
 // Warning: The discount cannot be restored after deletion.
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/cross_event_discounts/#ebapi-delete-discounts-discount-id
-func (c *Client) DiscountDelete(ctx context.Context, id string) (interface{}, error) {
-	var v interface{}
+func (c *Client) DiscountDelete(ctx context.Context, id string) (*DeleteResult, error) {
+	v := new(DeleteResult)
 
-	return v, c.deleteJSON(ctx, fmt.Sprintf("/discounts/%s/", id), &v)
+	return v, c.deleteJSON(ctx, fmt.Sprintf("/discounts/%s/", id), v)
 }
@@ -0,0 +1,45 @@
+package eventbrite
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestDiscountResponses(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code": "EARLY", "start_date": "2020-01-02T03:04:05Z", "end_date": "2020-02-02T03:04:05Z"}`))
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() (*CrossEventDiscount, error)
+	}{
+		{"get", func() (*CrossEventDiscount, error) { return c.DiscountsGet(ctx, "1") }},
+		{"create", func() (*CrossEventDiscount, error) {
+			return c.DiscountCreate(ctx, &DiscountCreateRequest{Code: "EARLY"})
+		}},
+		{"update", func() (*CrossEventDiscount, error) {
+			return c.DiscountUpdate(ctx, "1", &DiscountUpdateRequest{Code: "EARLY"})
+		}},
+	}
+
+	start := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := tt.call()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !d.StartDate.Time.Equal(start) || !d.EndDate.Time.Equal(start.AddDate(0, 1, 0)) {
+				t.Errorf("got dates %v and %v", d.StartDate.Time, d.EndDate.Time)
+			}
+			if len(d.Extra()) != 0 {
+				t.Errorf("got unknown fields %v", d.Extra())
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package eventbrite
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"golang.org/x/net/context"
+)
+
+// PlannedRequest is a POST or DELETE request recorded by a client in dry-run mode instead
+// of being sent
+type PlannedRequest struct {
+	// The name of the operation, e.g. "EventUpdate"
+	Operation string `json:"operation"`
+	// The HTTP method, POST or DELETE
+	Method string `json:"method"`
+	// The path of the request, relative to the base URL
+	Path string `json:"path"`
+	// The JSON body of a POST request, as it would have been sent
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// DryRunPlan records the requests of a client configured with WithDryRun. It may be shared
+// by several clients.
+type DryRunPlan struct {
+	mu       sync.Mutex
+	requests []PlannedRequest
+}
+
+// Requests returns the recorded requests, in order
+func (p *DryRunPlan) Requests() []PlannedRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]PlannedRequest(nil), p.requests...)
+}
+
+// Reset forgets the recorded requests
+func (p *DryRunPlan) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests = nil
+}
+
+func (p *DryRunPlan) add(r PlannedRequest) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests = append(p.requests, r)
+}
+
+// WithDryRun configures the client not to send its POST and DELETE requests: they are
+// validated and encoded as usual, then recorded into plan, and the operations succeed with
+// a synthesized response. The GET requests are still sent to the API.
+//
+// The synthesized response is the success flag of the operation, e.g. a PublishResult with
+// Published set, or else the object of the request body, e.g. the event of an EventUpdate
+// without the fields the API would fill in such as its ID.
+func WithDryRun(plan *DryRunPlan) ClientOption {
+	return func(c *Client) error {
+		c.dryRun = plan
+		return nil
+	}
+}
+
+// plan records a POST or DELETE request into the dry-run plan of the client and decodes the
+// synthesized response into resp
+func (c *Client) plan(ctx context.Context, method, path string, apiReq interface{}, resp interface{}) error {
+	var body []byte
+	if method == http.MethodPost {
+		var err error
+		if body, err = postBody(apiReq); err != nil {
+			return err
+		}
+	}
+
+	c.dryRun.add(PlannedRequest{Operation: OperationName(ctx), Method: method, Path: path, Body: body})
+	c.logger.Info("eventbrite: dry run, request not sent", "operation", OperationName(ctx), "method", method, "path", path)
+
+	// the response is best effort: a request body not matching it leaves it partly empty
+	json.Unmarshal(dryRunResponse(resp, body), resp)
+	return nil
+}
+
+// dryRunResponse returns the response body synthesized for a request not sent, decoded into resp
+func dryRunResponse(resp interface{}, body []byte) []byte {
+	switch resp.(type) {
+	case *PublishResult:
+		return []byte(`{"published":true}`)
+	case *UnpublishResult:
+		return []byte(`{"unpublished":true}`)
+	case *CancelResult:
+		return []byte(`{"canceled":true}`)
+	case *DeleteResult:
+		return []byte(`{"deleted":true}`)
+	case *CreateResult:
+		return []byte(`{"created":true}`)
+	}
+
+	// the object of a body such as {"event": {...}}
+	var obj map[string]json.RawMessage
+	if json.Unmarshal(body, &obj) == nil && len(obj) == 1 {
+		for _, v := range obj {
+			if bytes.HasPrefix(bytes.TrimSpace(v), []byte("{")) {
+				return v
+			}
+		}
+	}
+	return []byte("{}")
+}
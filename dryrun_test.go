@@ -0,0 +1,151 @@
+package eventbrite
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/net/context"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+func TestDryRun(t *testing.T) {
+	var sent int32
+	plan := &DryRunPlan{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&sent, 1)
+		if r.Method != http.MethodGet {
+			t.Errorf("%s %s sent in dry-run mode", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"name": "Hall"}`))
+	}, WithDryRun(plan))
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		call     func() (interface{}, error)
+		want     interface{}
+		planned  *PlannedRequest
+		wantSent int32
+	}{
+		{"get sent", func() (interface{}, error) { return c.VenueGet(ctx, "7") },
+			&Venue{Name: "Hall"}, nil, 1},
+		{"success flag", func() (interface{}, error) { return c.EventPublish(ctx, "7") },
+			&PublishResult{Published: true},
+			&PlannedRequest{Operation: "EventPublish", Method: http.MethodPost, Path: "/events/7/publish", Body: []byte(`{}`)}, 1},
+		{"delete", func() (interface{}, error) { return c.EventDelete(ctx, "7") },
+			&DeleteResult{Deleted: true},
+			&PlannedRequest{Operation: "EventDelete", Method: http.MethodDelete, Path: "/events/7"}, 1},
+		{"object of the body", func() (interface{}, error) {
+			return c.VenueCreate(ctx, &CreateVenueRequest{Name: "Room", Address1: "1 rue de Rivoli"})
+		}, &Venue{Name: "Room", Address: Address{Address1: "1 rue de Rivoli"}},
+			&PlannedRequest{Operation: "VenueCreate", Method: http.MethodPost, Path: "/venues/",
+				Body: []byte(`{"venue":{"address":{"address_1":"1 rue de Rivoli"},"name":"Room"}}`)}, 1},
+		{"body without object", func() (interface{}, error) {
+			return c.UserSaveBookmarks(ctx, "me", &UserSaveBookmarkRequest{EventIDs: []string{"7"}})
+		}, &CreateResult{Created: true},
+			&PlannedRequest{Operation: "UserSaveBookmarks", Method: http.MethodPost, Path: "/users/me/bookmarks/save/",
+				Body: []byte(`{"event_ids":["7"]}`)}, 1},
+	}
+
+	var want []PlannedRequest
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.call()
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the raw JSON of the synthesized response is left out of the comparison
+			reflect.ValueOf(got).Elem().FieldByName("RawFields").Set(reflect.ValueOf(RawFields{}))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got response %+v, want %+v", got, tt.want)
+			}
+
+			if tt.planned != nil {
+				want = append(want, *tt.planned)
+			}
+			if got := plan.Requests(); !reflect.DeepEqual(got, want) {
+				t.Errorf("got plan %s, want %s", planString(got), planString(want))
+			}
+			if got := atomic.LoadInt32(&sent); got != tt.wantSent {
+				t.Errorf("got %d requests sent, want %d", got, tt.wantSent)
+			}
+		})
+	}
+
+	plan.Reset()
+	if got := plan.Requests(); len(got) != 0 {
+		t.Errorf("got plan %s after Reset", planString(got))
+	}
+}
+
+func TestDryRunValidation(t *testing.T) {
+	plan := &DryRunPlan{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("%s %s sent", r.Method, r.URL.Path)
+	}, WithDryRun(plan))
+
+	_, err := c.VenueCreate(context.Background(), &CreateVenueRequest{Address1: "1 rue de Rivoli"})
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Field() != "Name" || errs[0].Tag() != "required" {
+		t.Errorf("got error %v, want the name to be required", err)
+	}
+	if got := plan.Requests(); len(got) != 0 {
+		t.Errorf("got plan %s for an invalid request", planString(got))
+	}
+}
+
+func TestDryRunSharedPlan(t *testing.T) {
+	plan := &DryRunPlan{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("%s %s sent", r.Method, r.URL.Path)
+	}
+	clients := []*Client{newTestClient(t, handler, WithDryRun(plan)), newTestClient(t, handler, WithDryRun(plan))}
+
+	for _, c := range clients {
+		if _, err := c.EventCancel(context.Background(), "7"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := plan.Requests(); len(got) != 2 {
+		t.Errorf("got plan %s, want the request of each client", planString(got))
+	}
+}
+
+func TestDryRunResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		resp interface{}
+		body string
+		want string
+	}{
+		{"publish", new(PublishResult), `{}`, `{"published":true}`},
+		{"unpublish", new(UnpublishResult), `{}`, `{"unpublished":true}`},
+		{"cancel", new(CancelResult), `{}`, `{"canceled":true}`},
+		{"delete", new(DeleteResult), ``, `{"deleted":true}`},
+		{"create", new(CreateResult), `{}`, `{"created":true}`},
+		{"object", new(Event), `{"event": {"name": {"html": "Gophercon"}}}`, `{"name": {"html": "Gophercon"}}`},
+		{"several objects", new(TicketClass), `{"ticket_class": {}, "event": {}}`, `{}`},
+		{"not an object", new(Venue), `{"venue": "Hall"}`, `{}`},
+		{"no body", new(Webhook), ``, `{}`},
+	}
+
+	for _, tt := range tests {
+		if got := string(dryRunResponse(tt.resp, []byte(tt.body))); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func planString(requests []PlannedRequest) string {
+	s := "["
+	for i, r := range requests {
+		if i > 0 {
+			s += " "
+		}
+		s += r.Operation + " " + r.Method + " " + r.Path + " " + string(r.Body)
+	}
+	return s + "]"
+}
@@ -0,0 +1,291 @@
+package eventbrite
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Error keys returned by the Eventbrite API in the error field of a failed response.
+//
+// https://www.eventbrite.co.uk/developer/v3/api_overview/errors/#ebapi-common-errors
+const (
+	ErrKeyNotFound          = "NOT_FOUND"
+	ErrKeyNotAuthorized     = "NOT_AUTHORIZED"
+	ErrKeyNoAuth            = "NO_AUTH"
+	ErrKeyInvalidAuth       = "INVALID_AUTH"
+	ErrKeyInvalidAuthHeader = "INVALID_AUTH_HEADER"
+	ErrKeyArgumentsError    = "ARGUMENTS_ERROR"
+	ErrKeyBadRequest        = "BAD_REQUEST"
+	ErrKeyHitRateLimit      = "HIT_RATE_LIMIT"
+	ErrKeyInternalError     = "INTERNAL_ERROR"
+)
+
+// Sentinel errors to be used with errors.Is against an error returned by the client, e.g.
+//
+//	if errors.Is(err, eventbrite.ErrNotFound) {
+//		// the requested object does not exist
+//	}
+//
+// To access the details of the failure use errors.As with an Error value.
+var (
+	// ErrNotFound matches responses with the 404 status or the NOT_FOUND error key
+	ErrNotFound = errors.New("eventbrite: not found")
+	// ErrUnauthorized matches authentication (401) and permission (403) failures
+	ErrUnauthorized = errors.New("eventbrite: not authorized")
+	// ErrRateLimited matches responses with the 429 status or the HIT_RATE_LIMIT error key
+	ErrRateLimited = errors.New("eventbrite: rate limited")
+	// ErrArguments matches ARGUMENTS_ERROR and BAD_REQUEST responses, and the 400 responses
+	// without an error key
+	ErrArguments = errors.New("eventbrite: invalid arguments")
+	// ErrServer matches responses in the 500 range
+	ErrServer = errors.New("eventbrite: server error")
+)
+
+// When an error occurs during an API request, you’ll get a response with an error HTTP status
+// (in the 400 or 500 range), as well as a JSON response containing more information about the error.
+//
+// https://www.eventbrite.co.uk/developer/v3/api_overview/errors/#ebapi-errors
+type Error struct {
+	// The error key contains a constant string value for error - in this case, VENUE_AND_ONLINE - and
+	// is what you should key your error handling off of, as this string won’t change depending on locale
+	// or as we change the API over time
+	Err string `json:"error,omitempty"`
+	// The error_description key is for developer information only and will usually contain a more informative
+	// explanation for the error, should you be confused. You should not display this string to your users;
+	// it’s often very technical and may not be localized to their language
+	Description string `json:"error_description,omitempty"`
+	// The status_code value just mirrors the HTTP status code you got as part of the request. It’s there as
+	// a convenience if your HTTP library makes it very hard to get status codes, or has one error handler
+	// for all error codes
+	Status int `json:"status_code,omitempty"`
+	// The HTTP method of the failed request
+	Method string `json:"-"`
+	// The API path of the failed request, relative to the base url
+	Path string `json:"-"`
+	// The raw response body as returned by the API
+	Body []byte `json:"-"`
+	// The parameters rejected by an ARGUMENTS_ERROR response, mapped back to the request fields
+	Fields FieldErrors `json:"-"`
+}
+
+func (e Error) Error() string {
+	desc := e.Description
+	if e.Err != "" {
+		desc = e.Err + ": " + desc
+	}
+	if e.Method == "" {
+		return fmt.Sprintf("Eventbrite API: [Status code - %d] %s", e.Status, desc)
+	}
+	return fmt.Sprintf("Eventbrite API: [Status code - %d] %s %s: %s", e.Status, e.Method, e.Path, desc)
+}
+
+// Is reports whether the error belongs to the class of the given sentinel error
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Status == http.StatusNotFound || e.Err == ErrKeyNotFound
+	case ErrUnauthorized:
+		switch e.Err {
+		case ErrKeyNotAuthorized, ErrKeyNoAuth, ErrKeyInvalidAuth, ErrKeyInvalidAuthHeader:
+			return true
+		}
+		return e.Status == http.StatusUnauthorized || e.Status == http.StatusForbidden
+	case ErrRateLimited:
+		return e.Status == http.StatusTooManyRequests || e.Err == ErrKeyHitRateLimit
+	case ErrArguments:
+		// other keys, such as INVALID_AUTH_HEADER, come with a 400 status too
+		if e.Err == "" {
+			return e.Status == http.StatusBadRequest
+		}
+		return e.Err == ErrKeyArgumentsError || e.Err == ErrKeyBadRequest
+	case ErrServer:
+		return e.Status >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// Unwrap exposes the field level errors of an ARGUMENTS_ERROR response to errors.As
+func (e Error) Unwrap() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e.Fields
+}
+
+// FieldError is a single request parameter rejected by the API
+type FieldError struct {
+	// The dotted API parameter name, e.g. event.start.utc
+	Param string
+	// The name of the request struct field bound to the parameter, e.g. StartUtc. Nested fields
+	// are separated by dots. Empty when the parameter does not belong to the request
+	Field string
+	// The error codes or messages reported for the parameter, e.g. INVALID
+	Messages []string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Param, strings.Join(e.Messages, ", "))
+}
+
+// FieldErrors is the list of parameters rejected by an ARGUMENTS_ERROR response
+type FieldErrors []FieldError
+
+func (fe FieldErrors) Error() string {
+	msgs := make([]string, len(fe))
+	for i, e := range fe {
+		msgs[i] = e.Error()
+	}
+	return "eventbrite: invalid arguments: " + strings.Join(msgs, "; ")
+}
+
+// ByField returns the error reported for the given request struct field, or nil
+func (fe FieldErrors) ByField(field string) *FieldError {
+	for i := range fe {
+		if fe[i].Field == field {
+			return &fe[i]
+		}
+	}
+	return nil
+}
+
+// ByParam returns the error reported for the given API parameter, or nil
+func (fe FieldErrors) ByParam(param string) *FieldError {
+	for i := range fe {
+		if fe[i].Param == param {
+			return &fe[i]
+		}
+	}
+	return nil
+}
+
+// errorDetail is the error_detail key of an error response. The parameters are either listed
+// at the top level or nested under the error key, e.g.
+//
+//	{"ARGUMENTS_ERROR": {"event.start.utc": ["INVALID"]}}
+type errorDetail struct {
+	ErrorDetail map[string]json.RawMessage `json:"error_detail"`
+}
+
+// parseFieldErrors extracts the rejected parameters of an ARGUMENTS_ERROR response body and
+// maps them onto the fields of apiReq
+func parseFieldErrors(body []byte, key string, apiReq interface{}) FieldErrors {
+	detail := errorDetail{}
+	if err := json.Unmarshal(body, &detail); err != nil || len(detail.ErrorDetail) == 0 {
+		return nil
+	}
+
+	params := detail.ErrorDetail
+	if nested, ok := params[key]; ok {
+		params = map[string]json.RawMessage{}
+		if err := json.Unmarshal(nested, &params); err != nil {
+			return nil
+		}
+	}
+
+	var fe FieldErrors
+	for param, raw := range params {
+		fe = append(fe, FieldError{
+			Param:    param,
+			Field:    fieldForParam(apiReq, param),
+			Messages: detailMessages(raw),
+		})
+	}
+	sort.Slice(fe, func(i, j int) bool { return fe[i].Param < fe[j].Param })
+
+	return fe
+}
+
+// detailMessages accepts either a single message or a list of messages
+func detailMessages(raw json.RawMessage) []string {
+	var list []string
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return list
+	}
+	var msg string
+	if err := json.Unmarshal(raw, &msg); err == nil {
+		return []string{msg}
+	}
+	return []string{string(raw)}
+}
+
+// fieldForParam returns the name of the struct field of apiReq whose json tag is the
+// API parameter. A parameter reported for a parent or a child of a tagged field, e.g.
+// event.start for event.start.utc, is mapped to that field
+func fieldForParam(apiReq interface{}, param string) string {
+	if apiReq == nil {
+		return ""
+	}
+	t := reflect.TypeOf(apiReq)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return ""
+	}
+
+	if f := findField(t, func(tag string) bool { return tag == param }); f != "" {
+		return f
+	}
+	return findField(t, func(tag string) bool {
+		return strings.HasPrefix(tag, param+".") || strings.HasPrefix(param, tag+".")
+	})
+}
+
+func findField(t reflect.Type, match func(tag string) bool) string {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := strings.Split(sf.Tag.Get("json"), ",")[0]
+		if tag == "-" {
+			continue
+		}
+		if tag == "" {
+			ft := sf.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct && sf.Anonymous {
+				if f := findField(ft, match); f != "" {
+					return sf.Name + "." + f
+				}
+			}
+			tag = sf.Name
+		}
+		if match(tag) {
+			return sf.Name
+		}
+	}
+	return ""
+}
+
+// checkResponse turns a non 2xx response into an Error carrying the status, the error key,
+// the request method and path and the raw response body. The parameters rejected by an
+// ARGUMENTS_ERROR response are mapped onto the fields of apiReq
+func checkResponse(resp *http.Response, method, path string, apiReq interface{}) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+
+	e := Error{}
+	json.Unmarshal(body, &e)
+
+	e.Status = resp.StatusCode
+	e.Method = method
+	e.Path = path
+	e.Body = body
+	if e.Description == "" {
+		e.Description = http.StatusText(resp.StatusCode)
+	}
+	if e.Err == ErrKeyArgumentsError {
+		e.Fields = parseFieldErrors(body, e.Err, apiReq)
+	}
+
+	return e
+}
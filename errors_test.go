@@ -0,0 +1,228 @@
+package eventbrite
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestErrorIs(t *testing.T) {
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrRateLimited, ErrArguments, ErrServer}
+
+	tests := []struct {
+		name string
+		err  Error
+		want []error
+	}{
+		{"not found status", Error{Status: 404}, []error{ErrNotFound}},
+		{"not found key", Error{Err: ErrKeyNotFound, Status: 400}, []error{ErrNotFound}},
+		{"unauthorized status", Error{Status: 401}, []error{ErrUnauthorized}},
+		{"forbidden status", Error{Status: 403}, []error{ErrUnauthorized}},
+		{"not authorized key", Error{Err: ErrKeyNotAuthorized, Status: 403}, []error{ErrUnauthorized}},
+		{"no auth key", Error{Err: ErrKeyNoAuth, Status: 401}, []error{ErrUnauthorized}},
+		{"invalid auth key", Error{Err: ErrKeyInvalidAuth, Status: 400}, []error{ErrUnauthorized}},
+		{"invalid auth header key", Error{Err: ErrKeyInvalidAuthHeader, Status: 400}, []error{ErrUnauthorized}},
+		{"rate limited status", Error{Status: 429}, []error{ErrRateLimited}},
+		{"rate limited key", Error{Err: ErrKeyHitRateLimit, Status: 429}, []error{ErrRateLimited}},
+		{"arguments key", Error{Err: ErrKeyArgumentsError, Status: 400}, []error{ErrArguments}},
+		{"bad request key", Error{Err: ErrKeyBadRequest, Status: 400}, []error{ErrArguments}},
+		{"bad request status", Error{Status: 400}, []error{ErrArguments}},
+		{"other key with bad request status", Error{Err: "VENUE_AND_ONLINE", Status: 400}, nil},
+		{"server status", Error{Status: 503}, []error{ErrServer}},
+		{"internal error key", Error{Err: ErrKeyInternalError, Status: 500}, []error{ErrServer}},
+		{"conflict", Error{Status: 409}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := map[error]bool{}
+			for _, s := range tt.want {
+				want[s] = true
+			}
+			for _, s := range sentinels {
+				if got := errors.Is(tt.err, s); got != want[s] {
+					t.Errorf("errors.Is(%v, %v) = %t, want %t", tt.err, s, got, want[s])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "ARGUMENTS_ERROR", "error_description": "There are errors with your arguments", "status_code": 400, "error_detail": {"venue.name": ["INVALID"]}}`))
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": "NOT_AUTHORIZED", "status_code": 403}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, WithRetryPolicy(RetryPolicy{}))
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		call       func() error
+		wantErr    Error
+		wantIs     error
+		wantFields FieldErrors
+	}{
+		{"get", func() error {
+			_, err := c.VenueGet(ctx, "7")
+			return err
+		}, Error{Status: 404, Description: "Not Found", Method: http.MethodGet, Path: "/venues/7/"}, ErrNotFound, nil},
+		{"post", func() error {
+			_, err := c.VenueUpdate(ctx, "7", &UpdateVenueRequest{Name: "x"})
+			return err
+		}, Error{Err: ErrKeyArgumentsError, Description: "There are errors with your arguments", Status: 400,
+			Method: http.MethodPost, Path: "/venues/7/"}, ErrArguments,
+			FieldErrors{{Param: "venue.name", Field: "Name", Messages: []string{"INVALID"}}}},
+		{"delete", func() error {
+			_, err := c.EventDelete(ctx, "7")
+			return err
+		}, Error{Err: ErrKeyNotAuthorized, Description: "Forbidden", Status: 403,
+			Method: http.MethodDelete, Path: "/events/7"}, ErrUnauthorized, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			var got Error
+			if !errors.As(err, &got) {
+				t.Fatalf("got error %v, want an Error", err)
+			}
+			if got.Err != tt.wantErr.Err || got.Description != tt.wantErr.Description || got.Status != tt.wantErr.Status ||
+				got.Method != tt.wantErr.Method || got.Path != tt.wantErr.Path {
+				t.Errorf("got %+v, want %+v", got, tt.wantErr)
+			}
+			if tt.wantErr.Err != "" && len(got.Body) == 0 {
+				t.Error("response body not kept")
+			}
+			if !errors.Is(err, tt.wantIs) {
+				t.Errorf("got error %v, want it to match %v", err, tt.wantIs)
+			}
+
+			if len(got.Fields) != len(tt.wantFields) {
+				t.Fatalf("got fields %v, want %v", got.Fields, tt.wantFields)
+			}
+			for i, f := range tt.wantFields {
+				g := got.Fields[i]
+				if g.Param != f.Param || g.Field != f.Field || len(g.Messages) != 1 || g.Messages[0] != f.Messages[0] {
+					t.Errorf("got field error %+v, want %+v", g, f)
+				}
+			}
+		})
+	}
+}
+
+func TestParseFieldErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		apiReq interface{}
+		want   FieldErrors
+	}{
+		{"top level", `{"error": "ARGUMENTS_ERROR", "error_detail": {"event.start.utc": ["INVALID"], "event.currency": "MISSING"}}`,
+			&EventCreateRequest{}, FieldErrors{
+				{Param: "event.currency", Field: "Currency", Messages: []string{"MISSING"}},
+				{Param: "event.start.utc", Field: "StartUtc", Messages: []string{"INVALID"}},
+			}},
+		{"nested under the error key", `{"error": "ARGUMENTS_ERROR", "error_detail": {"ARGUMENTS_ERROR": {"event.start.utc": ["INVALID", "PAST"]}}}`,
+			&EventCreateRequest{}, FieldErrors{
+				{Param: "event.start.utc", Field: "StartUtc", Messages: []string{"INVALID", "PAST"}},
+			}},
+		{"parent of a field", `{"error_detail": {"event.start": ["INVALID"]}}`,
+			&EventCreateRequest{}, FieldErrors{{Param: "event.start", Field: "StartUtc", Messages: []string{"INVALID"}}}},
+		{"child of a field", `{"error_detail": {"ticket_class.cost.currency": ["INVALID"]}}`,
+			&EventCreateTicketClass{}, FieldErrors{{Param: "ticket_class.cost.currency", Field: "Cost", Messages: []string{"INVALID"}}}},
+		{"unknown parameter", `{"error_detail": {"venue.name": ["INVALID"]}}`,
+			&EventCreateRequest{}, FieldErrors{{Param: "venue.name", Messages: []string{"INVALID"}}}},
+		{"no request", `{"error_detail": {"event.start.utc": ["INVALID"]}}`,
+			nil, FieldErrors{{Param: "event.start.utc", Messages: []string{"INVALID"}}}},
+		{"no detail", `{"error": "ARGUMENTS_ERROR"}`, &EventCreateRequest{}, nil},
+		{"not json", `<html>`, &EventCreateRequest{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseFieldErrors([]byte(tt.body), ErrKeyArgumentsError, tt.apiReq)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i, want := range tt.want {
+				if got[i].Param != want.Param || got[i].Field != want.Field || strings.Join(got[i].Messages, ",") != strings.Join(want.Messages, ",") {
+					t.Errorf("got %+v, want %+v", got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestFieldForParam(t *testing.T) {
+	type Embedded struct {
+		Extra string `json:"event.extra"`
+	}
+	type request struct {
+		Embedded
+		Name     string `json:"event.name.html"`
+		Skipped  string `json:"-"`
+		Untagged string
+	}
+
+	tests := []struct {
+		apiReq interface{}
+		param  string
+		want   string
+	}{
+		{&EventCreateRequest{}, "event.start.utc", "StartUtc"},
+		{EventCreateRequest{}, "event.end.timezone", "EndTimezone"},
+		{&EventCreateRequest{}, "event.name", "NameHtml"},
+		{&request{}, "event.name.html", "Name"},
+		{&request{}, "event.extra", "Embedded.Extra"},
+		{&request{}, "Untagged", "Untagged"},
+		{&request{}, "-", ""},
+		{&request{}, "event", "Embedded.Extra"},
+		{&request{}, "venue.name", ""},
+		{"not a struct", "event.name", ""},
+		{nil, "event.name", ""},
+	}
+
+	for _, tt := range tests {
+		if got := fieldForParam(tt.apiReq, tt.param); got != tt.want {
+			t.Errorf("fieldForParam(%T, %s) = %q, want %q", tt.apiReq, tt.param, got, tt.want)
+		}
+	}
+}
+
+func TestFieldErrorsLookup(t *testing.T) {
+	fe := parseFieldErrors([]byte(`{"error_detail": {"event.start.utc": ["INVALID"], "event.currency": ["MISSING"]}}`),
+		ErrKeyArgumentsError, &EventCreateRequest{})
+
+	if e := fe.ByField("StartUtc"); e == nil || e.Param != "event.start.utc" {
+		t.Errorf("ByField(StartUtc) = %v, want the event.start.utc error", e)
+	}
+	if e := fe.ByParam("event.currency"); e == nil || e.Field != "Currency" {
+		t.Errorf("ByParam(event.currency) = %v, want the Currency error", e)
+	}
+	if e := fe.ByField("EndUtc"); e != nil {
+		t.Errorf("ByField(EndUtc) = %v, want nil", e)
+	}
+	if e := fe.ByParam("event.end.utc"); e != nil {
+		t.Errorf("ByParam(event.end.utc) = %v, want nil", e)
+	}
+
+	err := error(Error{Err: ErrKeyArgumentsError, Status: 400, Fields: fe})
+	var got FieldErrors
+	if !errors.As(err, &got) || got.ByField("Currency") == nil {
+		t.Errorf("got field errors %v through errors.As, want %v", got, fe)
+	}
+	if want := "eventbrite: invalid arguments: event.currency: MISSING; event.start.utc: INVALID"; got.Error() != want {
+		t.Errorf("got %q, want %q", got.Error(), want)
+	}
+}
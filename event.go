@@ -2,7 +2,6 @@ package eventbrite
 
 import (
 	"fmt"
-	"net/url"
 
 	"golang.org/x/net/context"
 )
@@ -12,6 +11,8 @@ import (
 //
 // https://www.eventbrite.com/developer/v3/response_formats/event/#ebapi-event
 type Event struct {
+	RawFields
+
 	// Event ID
 	Id string `json:"id"`
 	// The event’s name
@@ -35,19 +36,19 @@ type Event struct {
 	Currency string `json:"currency"`
 	// If this event doesn’t have a venue and is only held online
 	OnlineEvent bool `json:"online_event"`
-	// The venue the event is held at (optional)
+	// The venue the event is held at (optional), populated with ExpandVenue
 	Venue   Venue  `json:"venue"`
 	VenueId string `json:"venue_id"`
-	// The organizer of the event
+	// The organizer of the event, populated with ExpandOrganizer
 	Organizer   Organizer `json:"organizer"`
 	OrganizerId string    `json:"organizer_id"`
-	// The event’s format (type of event: conference, seminar, concert, etc.) (optional)
+	// The event’s format (type of event: conference, seminar, concert, etc.) (optional), populated with ExpandFormat
 	Format   Format `json:"format"`
 	FormatId string `json:"format_id"`
-	// The event’s category (technology, music, science, etc.) (optional)
+	// The event’s category (technology, music, science, etc.) (optional), populated with ExpandCategory
 	Category   Category `json:"category"`
 	CategoryId string   `json:"category_id"`
-	// The event’s subcategory (optional)
+	// The event’s subcategory (optional), populated with ExpandSubcategory
 	SubCategory   SubCategory `json:"subcategory"`
 	SubCategoryId string      `json:"subcategory_id"`
 	LogoID        string      `json:"logo_id"`
@@ -58,6 +59,34 @@ type Event struct {
 	// The bookmark information on the event. Currently returns a dictionary with the number of users who
 	// have bookmarked the event as ‘count’ (i.e. {'count': 3})
 	BookmarkInfo interface{} `json:"bookmark_info"`
+	// The ticket classes of the event, populated with ExpandTicketClasses
+	TicketClasses []TicketClass `json:"ticket_classes"`
+	// Whether tickets are available and at what price, populated with ExpandTicketAvailability
+	TicketAvailability *TicketAvailability `json:"ticket_availability"`
+	// Whether the event is an occurrence of a repeating event series
+	IsSeries bool `json:"is_series"`
+	// Whether the event is the parent of a repeating event series
+	IsSeriesParent bool `json:"is_series_parent"`
+	// The ID of the series the event is part of
+	SeriesID string `json:"series_id"`
+}
+
+// TicketAvailability summarizes the tickets of an event that are on sale
+//
+// https://www.eventbrite.com/developer/v3/response_formats/event/#ebapi-ticket-availability
+type TicketAvailability struct {
+	// Whether any ticket class is on sale
+	HasAvailableTickets bool `json:"has_available_tickets"`
+	// The price of the cheapest ticket on sale
+	MinimumTicketPrice *Currency `json:"minimum_ticket_price"`
+	// The price of the most expensive ticket on sale
+	MaximumTicketPrice *Currency `json:"maximum_ticket_price"`
+	// Whether all tickets are sold out
+	IsSoldOut bool `json:"is_sold_out"`
+	// When the first ticket class goes on sale
+	StartSalesDate *DatetimeTz `json:"start_sales_date"`
+	// Whether the waitlist is open
+	WaitlistAvailable bool `json:"waitlist_available"`
 }
 
 // EventSearchRequest is the request structure for searching Event
@@ -65,64 +94,64 @@ type Event struct {
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-parameters
 type EventSearchRequest struct {
 	// Return events matching the given keywords. This parameter will accept any string as a keyword.
-	Query string `json:"q"`
+	Query string `json:"q,omitempty"`
 	// Parameter you want to sort by - options are “date”, “distance” and “best”. Prefix with a
 	// hyphen to reverse the order, e.g. “-date”.
-	SortBy string `json:"sort_by"`
+	SortBy string `json:"sort_by,omitempty"`
 	// The address of the location you want to search for events around.
-	LocationAddress string `json:"location.address"`
+	LocationAddress string `json:"location.address,omitempty"`
 	// The distance you want to search around the given location. This should be an integer followed by “mi” or “km”.
-	LocationWithin string `json:"location.within"`
+	LocationWithin string `json:"location.within,omitempty"`
 	// The latitude of of the location you want to search for events around.
-	LocationLatitude string `json:"location.latitude"`
+	LocationLatitude string `json:"location.latitude,omitempty"`
 	// The longitude of the location you want to search for events around.
-	LocationLongitude string `json:"location.longitude"`
+	LocationLongitude string `json:"location.longitude,omitempty"`
 	// The latitude of the northeast corner of a viewport.
-	LocationViewportNortheastLatitude string `json:"location.viewport.northeast.latitude"`
+	LocationViewportNortheastLatitude string `json:"location.viewport.northeast.latitude,omitempty"`
 	// The longitude of the northeast corner of a viewport.
-	LocationViewportNortheastLongitude string `json:"location.viewport.northeast.longitude"`
+	LocationViewportNortheastLongitude string `json:"location.viewport.northeast.longitude,omitempty"`
 	// The latitude of the southwest corner of a viewport.
-	LocationViewportSouthwestLatitude string `json:"location.viewport.southwest.latitude"`
+	LocationViewportSouthwestLatitude string `json:"location.viewport.southwest.latitude,omitempty"`
 	// The longitude of the southwest corner of a viewport.
-	LocationViewportSouthwestLongitude string `json:"location.viewport.southwest.longitude"`
+	LocationViewportSouthwestLongitude string `json:"location.viewport.southwest.longitude,omitempty"`
 	// Only return events organized by the given Organizer ID.
-	OrganizerId string `json:"organizer.id"`
+	OrganizerId string `json:"organizer.id,omitempty"`
 	// Only return events owned by the given User ID.
-	UserId string `json:"user.id"`
+	UserId string `json:"user.id,omitempty"`
 	// Append the given tracking_code to the event URLs returned.
-	TrackingCode string `json:"tracking_code"`
+	TrackingCode string `json:"tracking_code,omitempty"`
 	// Only return events under the given category IDs. This should be a comma delimited string of category IDs.
-	Categories string `json:"categories"`
+	Categories string `json:"categories,omitempty"`
 	// Only return events under the given subcategory IDs. This should be a comma delimited string of subcategory IDs.
-	Subcategories string `json:"subcategories"`
+	Subcategories string `json:"subcategories,omitempty"`
 	// Only return events with the given format IDs. This should be a comma delimited string of format IDs.
-	Formats string `json:"formats"`
+	Formats string `json:"formats,omitempty"`
 	//    Only return events that are “free” or “paid”
-	Price string `json:"price"`
+	Price string `json:"price,omitempty"`
 	// Only return events with start dates after the given date.
-	StartDateRangeStart string `json:"start_date.range_start"`
+	StartDateRangeStart string `json:"start_date.range_start,omitempty"`
 	// Only return events with start dates before the given date.
-	StartDateRangeEnd string `json:"start_date.range_end"`
+	StartDateRangeEnd string `json:"start_date.range_end,omitempty"`
 	// Only return events with start dates within the given keyword date range. Keyword options are “this_week”,
 	// “next_week”, “this_weekend”, “next_month”, “this_month”, “tomorrow”, “today”
-	StartDateKeyword string `json:"start_date.keyword"`
+	StartDateKeyword string `json:"start_date.keyword,omitempty"`
 	// Only return events with modified dates after the given UTC date.
-	DateModifiedRangeStart string `json:"date_modified.range_start"`
+	DateModifiedRangeStart string `json:"date_modified.range_start,omitempty"`
 	// Only return events with modified dates before the given UTC date.
-	DateModifiedEnd string `json:"date_modified.range_end"`
+	DateModifiedEnd string `json:"date_modified.range_end,omitempty"`
 	// Only return events with modified dates within the given keyword date range. Keyword options are “this_week”,
 	// “next_week”, “this_weekend”, “next_month”, “this_month”, “tomorrow”, “today”
-	DateModifiedKeyword string `json:"date_modified.keyword"`
+	DateModifiedKeyword string `json:"date_modified.keyword,omitempty"`
 	// Use the preconfigured settings for this type of search - Current option is “promoted”
-	SearchType string `json:"search_type"`
+	SearchType string `json:"search_type,omitempty"`
 	// Boolean for whether or not you want to see all instances of repeating events in search results.
-	IncludeAllSeriesInstances bool `json:"include_all_series_instances"`
+	IncludeAllSeriesInstances bool `json:"include_all_series_instances,omitempty"`
 	// Boolean for whether or not you want to see events without tickets on sale.
-	IncludeUnavailableEvents bool `json:"include_unavailable_events"`
+	IncludeUnavailableEvents bool `json:"include_unavailable_events,omitempty"`
 	// Incorporate additional information from the user’s historic preferences.
-	IncorporateUserAffinities bool `json:"incorporate_user_affinities"`
+	IncorporateUserAffinities bool `json:"incorporate_user_affinities,omitempty"`
 	// Make search results prefer events in these categories. This should be a comma delimited string of category IDs.
-	HighAffinityCategories string `json:"high_affinity_categories"`
+	HighAffinityCategories string `json:"high_affinity_categories,omitempty"`
 }
 
 // EventCreateRequest is the request structure for creating an Event
@@ -132,9 +161,9 @@ type EventCreateRequest struct {
 	// The name of the event. Value cannot be empty nor whitespace.
 	NameHtml string `json:"event.name.html" validate:"required"`
 	// The ID of the organizer of this event
-	DescriptionHtml string `json:"event.description.html"`
+	DescriptionHtml string `json:"event.description.html,omitempty"`
 	// The ID of the organizer of this event
-	OrganizerID string `json:"event.organizer_id"`
+	OrganizerID string `json:"event.organizer_id,omitempty"`
 	// The start time of the event
 	StartUtc DateTime `json:"event.start.utc" validate:"required"`
 	// Yes Start time timezone (Olson format)
@@ -144,48 +173,49 @@ type EventCreateRequest struct {
 	// End time timezone (Olson format)
 	EndTimezone string `json:"event.end.timezone" validate:"required"`
 	// Whether the start date should be hidden
-	HideStartDate bool `json:"event.hide_start_date"`
+	HideStartDate *bool `json:"event.hide_start_date,omitempty"`
 	// Whether the end date should be hidden
-	HideEndDate bool `json:"event.hide_end_date"`
+	HideEndDate *bool `json:"event.hide_end_date,omitempty"`
 	// Event currency (3 letter code)
 	Currency string `json:"event.currency" validate:"required"`
 	// The ID of a previously-created venue to associate with this event. You can omit this field or
 	// set it to null if you set online_event.
-	VenueId string `json:"event.venue_id"`
+	VenueId string `json:"event.venue_id,omitempty"`
 	// Is the event online-only (no venue)?
-	OnlineEvent bool `json:"event.online_event"`
+	OnlineEvent *bool `json:"event.online_event,omitempty"`
 	// If the event is publicly listed and searchable. Defaults to True.
-	Listed bool `json:"event.listed"`
+	Listed *bool `json:"event.listed,omitempty"`
 	// The logo for the event
-	LogoID string `json:"event.logo_id"`
+	LogoID string `json:"event.logo_id,omitempty"`
 	// The category (vertical) of the event
-	CategoryID string `json:"event.category_id"`
+	CategoryID string `json:"event.category_id,omitempty"`
 	// The subcategory of the event (US only)
-	SubcategoryID string `json:"event.subcategory_id"`
+	SubcategoryID string `json:"event.subcategory_id,omitempty"`
 	// The format (general type) of the event
-	FormatID string `json:"event.format_id"`
+	FormatID string `json:"event.format_id,omitempty"`
 	// If users can share the event on social media
-	Sharable bool `json:"event.shareable"`
+	Sharable *bool `json:"event.shareable,omitempty"`
 	// Only invited users can see the event page
-	InviteOnly bool `json:"event.invite_only"`
+	InviteOnly *bool `json:"event.invite_only,omitempty"`
 	// Password needed to see the event in unlisted mode
-	Password string `json:"event.password"`
+	Password string `json:"event.password,omitempty"`
 	// Set specific capacity (if omitted, sums ticket capacities)
-	Capacity int `json:"event.capacity"`
+	Capacity int `json:"event.capacity,omitempty"`
 	// If the remaining number of tickets is publicly visible on the event page
-	ShowRemaining bool `json:"event.show_remaining"`
+	ShowRemaining *bool `json:"event.show_remaining,omitempty"`
 	// If the event is reserved seating
-	IsReservedSeating bool `json:"event.is_reserved_seating"`
+	IsReservedSeating *bool `json:"event.is_reserved_seating,omitempty"`
 	// Source of the event (defaults to API)
-	Source string `json:"event.source"`
+	Source string `json:"event.source,omitempty"`
 }
 
-// EventUpdateRequest is the request structure for updating an Event
+// EventUpdateRequest is the request structure for updating an Event. The optional fields left
+// empty or nil are not changed.
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-id5
 type EventUpdateRequest struct {
 	// The name of the event. Value cannot be empty nor whitespace.
-	NameHtml string `json:"event.name.html"`
+	NameHtml string `json:"event.name.html,omitempty"`
 	// The ID of the organizer of this event
 	DescriptionHtml string `json:"event.description.html" validate:"required"`
 	// The ID of the organizer of this event
@@ -199,67 +229,67 @@ type EventUpdateRequest struct {
 	// End time timezone (Olson format)
 	EndTimezone string `json:"event.end.timezone" validate:"required"`
 	// Whether the start date should be hidden
-	HideStartDate bool `json:"event.hide_start_date"`
+	HideStartDate *bool `json:"event.hide_start_date,omitempty"`
 	// Whether the end date should be hidden
-	HideEndDate bool `json:"event.hide_end_date"`
+	HideEndDate *bool `json:"event.hide_end_date,omitempty"`
 	// Event currency (3 letter code)
 	Currency string `json:"event.currency" validate:"required"`
 	// The ID of a previously-created venue to associate with this event. You can omit this field or
 	// set it to null if you set online_event.
-	VenueID string `json:"event.venue_id"`
+	VenueID string `json:"event.venue_id,omitempty"`
 	// Is the event online-only (no venue)?
-	OnlineEvent bool `json:"event.online_event"`
+	OnlineEvent *bool `json:"event.online_event,omitempty"`
 	// If the event is publicly listed and searchable. Defaults to True.
-	Listed bool `json:"event.listed"`
+	Listed *bool `json:"event.listed,omitempty"`
 	// The logo for the event
-	LogoID string `json:"event.logo_id"`
+	LogoID string `json:"event.logo_id,omitempty"`
 	// The category (vertical) of the event
-	CategoryID string `json:"event.category_id"`
+	CategoryID string `json:"event.category_id,omitempty"`
 	// The subcategory of the event (US only)
-	SubcategoryID string `json:"event.subcategory_id"`
+	SubcategoryID string `json:"event.subcategory_id,omitempty"`
 	// The format (general type) of the event
-	FormatID string `json:"event.format_id"`
+	FormatID string `json:"event.format_id,omitempty"`
 	// If users can share the event on social media
-	Sharable bool `json:"event.shareable"`
+	Sharable *bool `json:"event.shareable,omitempty"`
 	// Only invited users can see the event page
-	InviteOnly bool `json:"event.invite_only"`
+	InviteOnly *bool `json:"event.invite_only,omitempty"`
 	// Password needed to see the event in unlisted mode
-	Password string `json:"event.password"`
+	Password string `json:"event.password,omitempty"`
 	// Set specific capacity (if omitted, sums ticket capacities)
-	Capacity int `json:"event.capacity"`
+	Capacity *int `json:"event.capacity,omitempty"`
 	// If the remaining number of tickets is publicly visible on the event page
-	ShowRemaining bool `json:"event.show_remaining"`
+	ShowRemaining *bool `json:"event.show_remaining,omitempty"`
 	// If the event is reserved seating
-	IsReservedSeating bool `json:"event.is_reserved_seating"`
+	IsReservedSeating *bool `json:"event.is_reserved_seating,omitempty"`
 	// Source of the event (defaults to API)
-	Source string `json:"event.source"`
+	Source string `json:"event.source,omitempty"`
 }
 
 // EventUpdateDisplaySettings is the request structure for updating an Event
-// display settings
+// display settings. The settings left nil are not changed.
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-id18
 type EventUpdateDisplaySettings struct {
 	// Whether to display the start date on the event listing
-	ShowStartDate bool `json:"display_settings.show_start_date"`
+	ShowStartDate *bool `json:"display_settings.show_start_date,omitempty"`
 	// Whether to display the end date on the event listing
-	ShowEndDate bool `json:"display_settings.show_end_date"`
+	ShowEndDate *bool `json:"display_settings.show_end_date,omitempty"`
 	// Whether to display event start and end time on the event listing
-	ShowStartEndTime bool `json:"display_settings.show_start_end_time"`
+	ShowStartEndTime *bool `json:"display_settings.show_start_end_time,omitempty"`
 	// Whether to display the event timezone on the event listing
-	ShowTimezone bool `json:"display_settings.show_timezone"`
+	ShowTimezone *bool `json:"display_settings.show_timezone,omitempty"`
 	// Whether to display a map to the venue on the event listing
-	ShowMap bool `json:"display_settings.show_map"`
+	ShowMap *bool `json:"display_settings.show_map,omitempty"`
 	// Whether to display the number of remaining tickets
-	ShowRemaining bool `json:"display_settings.show_remaining"`
+	ShowRemaining *bool `json:"display_settings.show_remaining,omitempty"`
 	// Whether to display a link to the organizer’s Facebook profile
-	ShowOrganizerFacebook bool `json:"display_settings.show_organizer_facebook"`
+	ShowOrganizerFacebook *bool `json:"display_settings.show_organizer_facebook,omitempty"`
 	// Whether to display a link to the organizer’s Twitter profile
-	ShowOrganizerTwitter bool `json:"display_settings.show_organizer_twitter"`
+	ShowOrganizerTwitter *bool `json:"display_settings.show_organizer_twitter,omitempty"`
 	// Whether to display which of the user’s Facebook friends are going
-	ShowFacebookFriendsGoing bool `json:"display_settings.show_facebook_friends_going"`
+	ShowFacebookFriendsGoing *bool `json:"display_settings.show_facebook_friends_going,omitempty"`
 	// Which terminology should be used to refer to the event (Valid choices are: tickets_vertical, or endurance_vertical)
-	ShowAttendeeList bool `json:"display_settings.show_attendee_list"`
+	ShowAttendeeList *bool `json:"display_settings.show_attendee_list,omitempty"`
 }
 
 // EventGetTicketClass is the request structure to get an Event TicketClass
@@ -267,7 +297,7 @@ type EventUpdateDisplaySettings struct {
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-id20
 type EventGetTicketClass struct {
 	// Only return ticket classes valid for the given point of sale (Valid choices are: online, or at_the_door)
-	Pos string `json:"pos"`
+	Pos string `json:"pos,omitempty"`
 }
 
 // EventGetTicketClass is the request structure to create an Event TicketClass
@@ -275,13 +305,13 @@ type EventGetTicketClass struct {
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-id22
 type EventCreateTicketClass struct {
 	// Name of this ticket type
-	Name string `json:"ticket_class.name"`
+	Name string `json:"ticket_class.name,omitempty"`
 	// Description of the ticket
-	Description string `json:"ticket_class.description"`
+	Description string `json:"ticket_class.description,omitempty"`
 	// Total available number of this ticket
-	QuantityTotal int `json:"quantity_total"`
+	QuantityTotal int `json:"ticket_class.quantity_total,omitempty"`
 	// Cost of the ticket (currently currency must match event currency) e.g. $45 would be ‘USD,4500’
-	Cost Currency `json:"ticket_class.quantity_total"`
+	Cost Currency `json:"ticket_class.cost,omitempty"`
 	// Is this a donation? (user-supplied cost)
 	Donation bool `json:"ticket_class.donation"`
 	// If the ticket is a free ticket
@@ -293,76 +323,78 @@ type EventCreateTicketClass struct {
 	// Hide the ticket description on the event page
 	HideDescription bool `json:"ticket_class.hide_description"`
 	// A list of all supported sales channels ([“online”], [“online”, “atd”], [“atd”])
-	SalesChannels []interface{} `json:"ticket_class.sales_channels"`
+	SalesChannels []interface{} `json:"ticket_class.sales_channels,omitempty"`
 	// When the ticket is available for sale (leave empty for ‘when event published’)
-	SalesStart string `json:"ticket_class.sales_start"`
+	SalesStart string `json:"ticket_class.sales_start,omitempty"`
 	// When the ticket stops being on sale (leave empty for ‘one hour before event start’)
-	SalesEnd string `json:"ticket_class.sales_end"`
+	SalesEnd string `json:"ticket_class.sales_end,omitempty"`
 	// The ID of another ticket class - when it sells out, this class will go on sale.
-	SalesStartAfter string `json:"ticket_class.sales_start_after"`
+	SalesStartAfter string `json:"ticket_class.sales_start_after,omitempty"`
 	// Minimum number that can be bought per order
-	MinimumQuantity int `json:"ticket_class.minimum_quantity"`
+	MinimumQuantity int `json:"ticket_class.minimum_quantity,omitempty"`
 	// Maximum number that can be bought per order
-	MaximumQuantity int `json:"ticket_class.maximum_quantity"`
+	MaximumQuantity int `json:"ticket_class.maximum_quantity,omitempty"`
 	// How many of these tickets have already been sold and confirmed (does not include tickets being checked out right now)
-	QuantitySold int `json:"quantity_sold"`
+	QuantitySold int `json:"quantity_sold,omitempty"`
 	// Hide this ticket
 	Hidden bool `json:"ticket_class.hidden"`
 	// Hide this ticket when it is not on sale
 	AutoHide bool `json:"ticket_class.auto_hide"`
 	// Override reveal date for auto-hide
-	AutoHideBefore string `json:"ticket_class.auto_hide_before"`
+	AutoHideBefore string `json:"ticket_class.auto_hide_before,omitempty"`
 	// Override re-hide date for auto-hide
-	AutoHideAfter string `json:"ticket_class.auto_hide_after"`
+	AutoHideAfter string `json:"ticket_class.auto_hide_after,omitempty"`
 	// Order message per ticket type
-	OrderConfirmationMessage string `json:"ticket_class.order_confirmation_message"`
+	OrderConfirmationMessage string `json:"ticket_class.order_confirmation_message,omitempty"`
 }
 
-// EventUpdateTicketClass is the request structure to update an Event TicketClass
+// EventUpdateTicketClass is the request structure to update an Event TicketClass. The
+// fields left empty or nil are not changed.
+//
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-id26
 type EventUpdateTicketClass struct {
 	// Name of this ticket type
-	Name string `json:"ticket_class.name"`
+	Name string `json:"ticket_class.name,omitempty"`
 	// Description of the ticket
-	Description string `json:"ticket_class.description"`
+	Description string `json:"ticket_class.description,omitempty"`
 	// Total available number of this ticket
-	QuantityTotal int `json:"quantity_total"`
+	QuantityTotal *int `json:"ticket_class.quantity_total,omitempty"`
 	// Cost of the ticket (currently currency must match event currency) e.g. $45 would be ‘USD,4500’
-	Cost Currency `json:"ticket_class.quantity_total"`
+	Cost Currency `json:"ticket_class.cost,omitempty"`
 	// Is this a donation? (user-supplied cost)
-	Donation bool `json:"ticket_class.donation"`
+	Donation *bool `json:"ticket_class.donation,omitempty"`
 	// If the ticket is a free ticket
-	Free bool `json:"ticket_class.free"`
+	Free *bool `json:"ticket_class.free,omitempty"`
 	// Absorb the fee into the displayed cost
-	IncludeFee bool `json:"ticket_class.include_fee"`
+	IncludeFee *bool `json:"ticket_class.include_fee,omitempty"`
 	// Absorb the payment fee, but show the eventbrite fee
-	SplitFee bool `json:"ticket_class.split_fee"`
+	SplitFee *bool `json:"ticket_class.split_fee,omitempty"`
 	// Hide the ticket description on the event page
-	HideDescription bool `json:"ticket_class.hide_description"`
+	HideDescription *bool `json:"ticket_class.hide_description,omitempty"`
 	// A list of all supported sales channels ([“online”], [“online”, “atd”], [“atd”])
-	SalesChannels []interface{} `json:"ticket_class.sales_channels"`
+	SalesChannels []interface{} `json:"ticket_class.sales_channels,omitempty"`
 	// When the ticket is available for sale (leave empty for ‘when event published’)
-	SalesStart string `json:"ticket_class.sales_start"`
+	SalesStart string `json:"ticket_class.sales_start,omitempty"`
 	// When the ticket stops being on sale (leave empty for ‘one hour before event start’)
-	SalesEnd string `json:"ticket_class.sales_end"`
+	SalesEnd string `json:"ticket_class.sales_end,omitempty"`
 	// The ID of another ticket class - when it sells out, this class will go on sale.
-	SalesStartAfter string `json:"ticket_class.sales_start_after"`
+	SalesStartAfter string `json:"ticket_class.sales_start_after,omitempty"`
 	// Minimum number that can be bought per order
-	MinimumQuantity int `json:"ticket_class.minimum_quantity"`
+	MinimumQuantity *int `json:"ticket_class.minimum_quantity,omitempty"`
 	// Maximum number that can be bought per order
-	MaximumQuantity int `json:"ticket_class.maximum_quantity"`
+	MaximumQuantity *int `json:"ticket_class.maximum_quantity,omitempty"`
 	// How many of these tickets have already been sold and confirmed (does not include tickets being checked out right now)
-	QuantitySold int `json:"quantity_sold"`
+	QuantitySold int `json:"quantity_sold,omitempty"`
 	// Hide this ticket
-	Hidden bool `json:"ticket_class.hidden"`
+	Hidden *bool `json:"ticket_class.hidden,omitempty"`
 	// Hide this ticket when it is not on sale
-	AutoHide bool `json:"ticket_class.auto_hide"`
+	AutoHide *bool `json:"ticket_class.auto_hide,omitempty"`
 	// Override reveal date for auto-hide
-	AutoHideBefore string `json:"ticket_class.auto_hide_before"`
+	AutoHideBefore string `json:"ticket_class.auto_hide_before,omitempty"`
 	// Override re-hide date for auto-hide
-	AutoHideAfter string `json:"ticket_class.auto_hide_after"`
+	AutoHideAfter string `json:"ticket_class.auto_hide_after,omitempty"`
 	// Order message per ticket type
-	OrderConfirmationMessage string `json:"ticket_class.order_confirmation_message"`
+	OrderConfirmationMessage string `json:"ticket_class.order_confirmation_message,omitempty"`
 }
 
 // EventDeleteTicketClass is the request structure to delkete an Event TicketClass
@@ -379,7 +411,7 @@ type EventDeleteTicketClass struct {
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-id31
 type EventGetCannedQuestions struct {
 	// Return private events and more details
-	AsOwner bool `json:"as_owner"`
+	AsOwner bool `json:"as_owner,omitempty"`
 }
 
 // EventCreateCannedQuestion is the request structure to create an Event canned question
@@ -387,26 +419,26 @@ type EventGetCannedQuestions struct {
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-id33
 type EventCreateCannedQuestion struct {
 	// Question displayed to the recipient
-	Html string `json:"question.question.html"`
+	Html string `json:"question.question.html,omitempty"`
 	// Is an answer to this question required for registration?
 	Required bool `json:"question.required"`
 	// Type of Question (Valid choices are: checkbox, dropdown, text, paragraph, radio, or waiver)
-	Type string `json:"question.type"`
+	Type string `json:"question.type,omitempty"`
 	// Ask this question to the ticket buyer or each attendee? (Valid choices are: ticket_buyer, or attendee)
 	Respondent string `json:"question.respondent" validate:"required"`
 	// Waiver content for questions of type waiver
-	Waiver string `json:"question.waiver"`
+	Waiver string `json:"question.waiver,omitempty"`
 	// Choices for multiple choice questions. Format:
 	// [{“answer”: {“html”: “Choice goes here...”}}, {“answer”: {“html”: “Another choice goes here...”}}]
-	Choices interface{} `json:"question.choices"`
+	Choices interface{} `json:"question.choices,omitempty"`
 	// Tickets to which to limit this question. Format: [{“id”: “1234”}, {“id”: “4567”}]
-	TicketClasses interface{} `json:"question.ticket_classes"`
+	TicketClasses interface{} `json:"question.ticket_classes,omitempty"`
 	// ID of Parent Question (for subquestions)
-	ParentChoiceID string `json:"question.parent_choice_id"`
+	ParentChoiceID string `json:"question.parent_choice_id,omitempty"`
 	// Is this question displayed on order confirmation?
 	DisplayAnswerOnOrder bool `json:"question.display_answer_on_order"`
 	// String value of canned_type
-	CannedType string `json:"question.canned_type"`
+	CannedType string `json:"question.canned_type,omitempty"`
 }
 
 // EventGetQuestions is the request structure to get an Event questions
@@ -414,7 +446,7 @@ type EventCreateCannedQuestion struct {
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-id35
 type EventGetQuestions struct {
 	// Return private events and more details
-	AsOwner bool `json:"as_owner"`
+	AsOwner bool `json:"as_owner,omitempty"`
 }
 
 // EventCreateQuestion is the request structure to create an Event question
@@ -422,26 +454,75 @@ type EventGetQuestions struct {
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-id37
 type EventCreateQuestion struct {
 	// Question displayed to the recipient
-	Html string `json:"question.question.html"`
+	Html string `json:"question.question.html,omitempty"`
 	// Is an answer to this question required for registration?
 	Required bool `json:"question.required"`
 	// Type of Question (Valid choices are: checkbox, dropdown, text, paragraph, radio, or waiver)
-	Type string `json:"question.type"`
+	Type string `json:"question.type,omitempty"`
 	// Ask this question to the ticket buyer or each attendee? (Valid choices are: ticket_buyer, or attendee)
 	Respondent string `json:"question.respondent" validate:"required"`
 	// Waiver content for questions of type waiver
-	Waiver string `json:"question.waiver"`
+	Waiver string `json:"question.waiver,omitempty"`
 	// Choices for multiple choice questions. Format:
 	// [{“answer”: {“html”: “Choice goes here...”}}, {“answer”: {“html”: “Another choice goes here...”}}]
-	Choices interface{} `json:"question.choices"`
+	Choices interface{} `json:"question.choices,omitempty"`
 	// Tickets to which to limit this question. Format: [{“id”: “1234”}, {“id”: “4567”}]
-	TicketClasses interface{} `json:"question.ticket_classes"`
+	TicketClasses interface{} `json:"question.ticket_classes,omitempty"`
 	// ID of Parent Question (for subquestions)
-	ParentChoiceID string `json:"question.parent_choice_id"`
+	ParentChoiceID string `json:"question.parent_choice_id,omitempty"`
 	// Is this question displayed on order confirmation?
 	DisplayAnswerOnOrder bool `json:"question.display_answer_on_order"`
 }
 
+// Question is a question asked to the attendees of an event, either a custom question or a
+// canned question such as the first name
+//
+// https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-get-events-id-questions
+type Question struct {
+	RawFields
+
+	ID string `json:"id"`
+	// Question displayed to the recipient
+	Question MultipartText `json:"question"`
+	// Type of Question (checkbox, dropdown, text, paragraph, radio, or waiver)
+	Type string `json:"type"`
+	// Is an answer to this question required for registration?
+	Required bool `json:"required"`
+	// Ask this question to the ticket buyer or each attendee? (ticket_buyer, or attendee)
+	Respondent string `json:"respondent"`
+	// Waiver content for questions of type waiver
+	Waiver string `json:"waiver"`
+	// Choices for multiple choice questions
+	Choices []QuestionChoice `json:"choices"`
+	// Tickets to which the question is limited
+	TicketClasses []TicketClass `json:"ticket_classes"`
+	// ID of Parent Question (for subquestions)
+	ParentID string `json:"parent_id"`
+	// ID of the choice of the parent question showing this subquestion
+	ParentChoiceID string `json:"parent_choice_id"`
+	// Is this question displayed on order confirmation?
+	DisplayAnswerOnOrder bool `json:"display_answer_on_order"`
+	// The canned question type, e.g. first_name, of canned questions
+	CannedType string `json:"canned_type"`
+}
+
+// QuestionChoice is an answer of a multiple choice question
+type QuestionChoice struct {
+	ID string `json:"id"`
+	// The answer displayed to the recipient
+	Answer MultipartText `json:"answer"`
+	// The subquestions shown when the choice is selected
+	SubquestionIDs []string `json:"subquestion_ids"`
+}
+
+// QuestionsResult is the response structure for the questions of an Event
+type QuestionsResult struct {
+	RawFields
+
+	Pagination Pagination `json:"pagination"`
+	Questions  []Question `json:"questions"`
+}
+
 // EventGetAttendees is the request structure to get an Event Attendees list
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-id41
@@ -463,18 +544,18 @@ type EventGetAttendees struct {
 type EventGetOrders struct {
 	// Limits results to either confirmed attendees or cancelled/refunded/etc.
 	// attendees (Valid choices are: attending, not_attending, or unpaid)
-	Status string `json:"status"`
+	Status string `json:"status,omitempty"`
 	// Only return attendees changed on or after the time given
-	ChangedSince string `json:"changed_since"`
+	ChangedSince string `json:"changed_since,omitempty"`
 	// Only return attendees changed on or after the time given and with an id bigger than last item seen
-	LastItemSeen int `json:"last_item_seen"`
+	LastItemSeen int `json:"last_item_seen,omitempty"`
 	// Only include orders placed by one of these emails
-	OnlyEmails []interface{} `json:"only_emails"`
+	OnlyEmails []interface{} `json:"only_emails,omitempty"`
 	// Don’t include orders placed by any of these emails
-	ExcludeEmails []interface{} `json:"only_emails"`
+	ExcludeEmails []interface{} `json:"exclude_emails,omitempty"`
 	// Return only orders with selected refund requests statuses.
 	// Possible values are: completed, pending, outside_policy, disputed, denied
-	RefundRequestStatuses []interface{} `json:"refund_request_statuses"`
+	RefundRequestStatuses []interface{} `json:"refund_request_statuses,omitempty"`
 }
 
 // EventGetTransfers is the request structure to get an Event Transfer list
@@ -504,6 +585,8 @@ type EventGetTicketGroupsTicketClasses struct {
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-events
 type EventSearchResult struct {
+	RawFields
+
 	Pagination     Pagination `json:"pagination"`
 	Events         []Event    `json:"events"`
 	TopMatchEvents []Event    `json:"top_match_events"`
@@ -513,6 +596,8 @@ type EventSearchResult struct {
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-get-events-id-ticket-classes
 type EventGetTicketClassResult struct {
+	RawFields
+
 	Pagination    Pagination    `json:"pagination"`
 	TicketClasses []TicketClass `json:"ticket_classes"`
 }
@@ -520,39 +605,53 @@ type EventGetTicketClassResult struct {
 // EventSearch allows you to retrieve a paginated response of public event objects from across
 // Eventbrite’s directory, regardless of which user owns the event.
 //
+// Valid expansions: venue, organizer, format, category, subcategory, ticket_classes,
+// ticket_availability, bookmark_info, refund_policy.
+//
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-events
-func (c *Client) EventSearch(ctx context.Context, req *EventSearchRequest) (*EventSearchResult, error) {
+func (c *Client) EventSearch(ctx context.Context, req *EventSearchRequest, expand ...Expansion) (*EventSearchResult, error) {
 	result := &EventSearchResult{}
 
-	return result, c.getJSON(ctx, "/events/search/", req, &result)
+	return result, c.getJSON(withExpansions(ctx, expand), "/events/search/", req, &result)
+}
+
+// EventSearchIterator returns an iterator walking all the pages of EventSearch
+func (c *Client) EventSearchIterator(ctx context.Context, req *EventSearchRequest, expand ...Expansion) *Iterator[Event] {
+	return newIterator(ctx, func(ctx context.Context) ([]Event, Pagination, error) {
+		r, err := c.EventSearch(ctx, req, expand...)
+		return r.Events, r.Pagination, err
+	})
 }
 
 // EventGet returns an event for the specified event. Many of Eventbrite’s API use cases revolve around pulling
 // details of a specific event within an Eventbrite account. Does not support fetching a repeating event
 // series parent (see GET /series/:id/).
 //
+// Valid expansions: venue, organizer, format, category, subcategory, ticket_classes,
+// ticket_availability, bookmark_info, refund_policy.
+//
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-get-events-id
-func (c *Client) EventGet(ctx context.Context, id string) (*Event, error) {
+func (c *Client) EventGet(ctx context.Context, id string, expand ...Expansion) (*Event, error) {
 	result := &Event{}
 
-	return result, c.getJSON(ctx, "/events/"+id, url.Values{}, &result)
+	return result, c.getJSON(withExpansions(ctx, expand), "/events/"+id, nil, result)
 }
 
 // EventCreate makes a new event, and returns an event for the specified event. Does not support the
 // creation of repeating event series.
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-post-events
-func (c *Client) EventCreate(ctx context.Context, req *EventCreateRequest) (interface{}, error) {
-	var resp interface{}
+func (c *Client) EventCreate(ctx context.Context, req *EventCreateRequest) (*Event, error) {
+	event := &Event{}
 
-	return resp, c.postJSON(ctx, "/events/", req, &resp)
+	return event, c.postJSON(ctx, "/events/", req, event)
 }
 
 // EventUpdate updates an event. Returns an event for the specified event. Does not support updating a
 // repeating event series parent (see POST /series/:id/)
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-post-events-id
-func (c *Client) EventUpdate(ctx context.Context, id string, req *EventUpdateRequest) (interface{}, error) {
+func (c *Client) EventUpdate(ctx context.Context, id string, req *EventUpdateRequest) (*Event, error) {
 	event := &Event{}
 
 	return event, c.postJSON(ctx, fmt.Sprintf("/events/%s/", id), req, event)
@@ -564,11 +663,11 @@ func (c *Client) EventUpdate(ctx context.Context, id string, req *EventUpdateReq
 // fail to validate the publish requirements. Returns a boolean indicating success or failure of the publish.
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-events
-func (c *Client) EventPublish(ctx context.Context, id string) (interface{}, error) {
+func (c *Client) EventPublish(ctx context.Context, id string) (*PublishResult, error) {
 	path := fmt.Sprintf("/events/%s/publish", id)
 
-	var resp interface{}
-	return resp, c.postJSON(ctx, path, nil, &resp)
+	resp := new(PublishResult)
+	return resp, c.postJSON(ctx, path, nil, resp)
 }
 
 // EventUnPublish unpublishes an event. In order for a free event to be unpublished, it must not have any pending or completed
@@ -577,33 +676,33 @@ func (c *Client) EventPublish(ctx context.Context, id string) (interface{}, erro
 // success or failure of the unpublish.
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-post-events-id-unpublish
-func (c *Client) EventUnPublish(ctx context.Context, id string) (interface{}, error) {
+func (c *Client) EventUnPublish(ctx context.Context, id string) (*UnpublishResult, error) {
 	path := fmt.Sprintf("/events/%s/unpublish", id)
 
-	var resp interface{}
-	return resp, c.postJSON(ctx, path, nil, &resp)
+	resp := new(UnpublishResult)
+	return resp, c.postJSON(ctx, path, nil, resp)
 }
 
 // EventCancel cancels an event if it has not already been deleted. In order for cancel to be permitted, there must be no
 // pending or completed orders. Returns a boolean indicating success or failure of the cancel.
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-post-events-id-cancel
-func (c *Client) EventCancel(ctx context.Context, id string) (interface{}, error) {
-	path := fmt.Sprintf("/events/%s/unpublish", id)
+func (c *Client) EventCancel(ctx context.Context, id string) (*CancelResult, error) {
+	path := fmt.Sprintf("/events/%s/cancel", id)
 
-	var resp interface{}
-	return resp, c.postJSON(ctx, path, nil, &resp)
+	resp := new(CancelResult)
+	return resp, c.postJSON(ctx, path, nil, resp)
 }
 
 // EventDelete deletes an event if the delete is permitted. In order for a delete to be permitted, there must be no pending
 // or completed orders. Returns a boolean indicating success or failure of the delete.
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-delete-events-id
-func (c *Client) EventDelete(ctx context.Context, id string) (interface{}, error) {
+func (c *Client) EventDelete(ctx context.Context, id string) (*DeleteResult, error) {
 	path := fmt.Sprintf("/events/%s", id)
 
-	var resp interface{}
-	return resp, c.deleteJSON(ctx, path, &resp)
+	resp := new(DeleteResult)
+	return resp, c.deleteJSON(ctx, path, resp)
 }
 
 // EventGetDisplaySettings gets Event display settings
@@ -612,7 +711,7 @@ func (c *Client) EventDelete(ctx context.Context, id string) (interface{}, error
 func (c *Client) EventGetDisplaySettings(ctx context.Context, id string) (*EventSettings, error) {
 	result := new(EventSettings)
 
-	return result, c.getJSON(ctx, fmt.Sprintf("/events/%s/display_settings/", id), url.Values{}, &result)
+	return result, c.getJSON(ctx, fmt.Sprintf("/events/%s/display_settings/", id), nil, result)
 }
 
 // EventUpdateDisplaySettings apdates the display settings for an Event.
@@ -621,7 +720,7 @@ func (c *Client) EventGetDisplaySettings(ctx context.Context, id string) (*Event
 func (c *Client) EventUpdateDisplaySettings(ctx context.Context, id string, settings *EventUpdateDisplaySettings) (*EventSettings, error) {
 	result := new(EventSettings)
 
-	return result, c.postJSON(ctx, fmt.Sprintf("/events/%s/display_settings/", id), settings, &result)
+	return result, c.postJSON(ctx, fmt.Sprintf("/events/%s/display_settings/", id), settings, result)
 }
 
 // EventGetTicketClasses gets an Event TicketClass
@@ -633,6 +732,14 @@ func (c *Client) EventGetTicketClasses(ctx context.Context, id string, class *Ev
 	return result, c.getJSON(ctx, fmt.Sprintf("/events/%s/ticket_classes/", id), class, result)
 }
 
+// EventGetTicketClassesIterator returns an iterator walking all the pages of EventGetTicketClasses
+func (c *Client) EventGetTicketClassesIterator(ctx context.Context, id string, class *EventGetTicketClass) *Iterator[TicketClass] {
+	return newIterator(ctx, func(ctx context.Context) ([]TicketClass, Pagination, error) {
+		r, err := c.EventGetTicketClasses(ctx, id, class)
+		return r.TicketClasses, r.Pagination, err
+	})
+}
+
 // EventCreateTicketClass creates a new ticket class, returning the result as a ticket_class under the key ticket_class.
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-post-events-id-ticket-classes
@@ -657,14 +764,14 @@ func (c *Client) EventGetTicketClass(ctx context.Context, eventId, ticketId stri
 func (c *Client) EventUpdateTicketClass(ctx context.Context, eventId, ticketId string, class *EventUpdateTicketClass) (*TicketClass, error) {
 	result := new(TicketClass)
 
-	return result, c.postJSON(ctx, fmt.Sprintf("/events/%s/ticket_classes/%s/", eventId, ticketId), nil, result)
+	return result, c.postJSON(ctx, fmt.Sprintf("/events/%s/ticket_classes/%s/", eventId, ticketId), class, result)
 }
 
 // EventDeleteTicketClass deletes the ticket class. Returns {"deleted": true}
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-delete-events-id-ticket-classes-ticket-class-id
-func (c *Client) EventDeleteTicketClass(ctx context.Context, eventId, ticketId string, class *EventDeleteTicketClass) (interface{}, error) {
-	result := new(TicketClass)
+func (c *Client) EventDeleteTicketClass(ctx context.Context, eventId, ticketId string, class *EventDeleteTicketClass) (*DeleteResult, error) {
+	result := new(DeleteResult)
 
 	return result, c.deleteJSON(ctx, fmt.Sprintf("/events/%s/ticket_classes/%s/", eventId, ticketId), result)
 }
@@ -673,19 +780,27 @@ func (c *Client) EventDeleteTicketClass(ctx context.Context, eventId, ticketId s
 // (examples: first name, last name, company, prefix, etc.).
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-get-events-id-canned-questions
-func (c *Client) EventGetCannedQuestions(ctx context.Context, id string, q *EventGetCannedQuestions) (interface{}, error) {
-	var result interface{}
+func (c *Client) EventGetCannedQuestions(ctx context.Context, id string, q *EventGetCannedQuestions) (*QuestionsResult, error) {
+	result := new(QuestionsResult)
 
-	return result, c.getJSON(ctx, fmt.Sprintf("/events/%d/canned_questions/", id), q, result)
+	return result, c.getJSON(ctx, fmt.Sprintf("/events/%s/canned_questions/", id), q, result)
+}
+
+// EventGetCannedQuestionsIterator returns an iterator walking all the pages of EventGetCannedQuestions
+func (c *Client) EventGetCannedQuestionsIterator(ctx context.Context, id string, q *EventGetCannedQuestions) *Iterator[Question] {
+	return newIterator(ctx, func(ctx context.Context) ([]Question, Pagination, error) {
+		r, err := c.EventGetCannedQuestions(ctx, id, q)
+		return r.Questions, r.Pagination, err
+	})
 }
 
 // EventCreateCannedQuestion creates a new canned question; returns the result as a question
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-post-events-id-canned-questions
-func (c *Client) EventCreateCannedQuestion(ctx context.Context, id string, q *EventCreateCannedQuestion) (interface{}, error) {
-	var result interface{}
+func (c *Client) EventCreateCannedQuestion(ctx context.Context, id string, q *EventCreateCannedQuestion) (*Question, error) {
+	result := new(Question)
 
-	return result, c.postJSON(ctx, fmt.Sprintf("/events/%d/canned_questions/", id), q, result)
+	return result, c.postJSON(ctx, fmt.Sprintf("/events/%s/canned_questions/", id), q, result)
 }
 
 // Eventbrite allows event organizers to add custom questions that attendees fill out upon registration.
@@ -693,17 +808,25 @@ func (c *Client) EventCreateCannedQuestion(ctx context.Context, id string, q *Ev
 // This endpoint will return question
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-get-events-id-questions
-func (c *Client) EventGetQuestions(ctx context.Context, id string, q *EventGetQuestions) (interface{}, error) {
-	var result interface{}
+func (c *Client) EventGetQuestions(ctx context.Context, id string, q *EventGetQuestions) (*QuestionsResult, error) {
+	result := new(QuestionsResult)
 
-	return result, c.postJSON(ctx, fmt.Sprintf("/events/%s/questions/", id), q, result)
+	return result, c.getJSON(ctx, fmt.Sprintf("/events/%s/questions/", id), q, result)
+}
+
+// EventGetQuestionsIterator returns an iterator walking all the pages of EventGetQuestions
+func (c *Client) EventGetQuestionsIterator(ctx context.Context, id string, q *EventGetQuestions) *Iterator[Question] {
+	return newIterator(ctx, func(ctx context.Context) ([]Question, Pagination, error) {
+		r, err := c.EventGetQuestions(ctx, id, q)
+		return r.Questions, r.Pagination, err
+	})
 }
 
 // EventCreateQuestion creates a new question; returns the result as a question as the key question
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-post-events-id-questions
-func (c *Client) EventCreateQuestion(ctx context.Context, id string, q *EventCreateQuestion) (interface{}, error) {
-	var result interface{}
+func (c *Client) EventCreateQuestion(ctx context.Context, id string, q *EventCreateQuestion) (*Question, error) {
+	result := new(Question)
 
 	return result, c.postJSON(ctx, fmt.Sprintf("/events/%s/questions/", id), q, result)
 }
@@ -711,8 +834,8 @@ func (c *Client) EventCreateQuestion(ctx context.Context, id string, q *EventCre
 // EventGetQuestion returns question for a specific question id
 //
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-get-events-id-questions-id
-func (c *Client) EventGetQuestion(ctx context.Context, eventId, questionId string) (interface{}, error) {
-	var result interface{}
+func (c *Client) EventGetQuestion(ctx context.Context, eventId, questionId string) (*Question, error) {
+	result := new(Question)
 
-	return result, c.postJSON(ctx, fmt.Sprintf("/events/%s/questions/%s/", eventId, questionId), nil, result)
+	return result, c.getJSON(ctx, fmt.Sprintf("/events/%s/questions/%s/", eventId, questionId), nil, result)
 }
@@ -10,9 +10,9 @@ type SeriesCreateEventRequest struct {
 	// The name of the event
 	Name string `json:"series_parent.name.html" validate:"required"`
 	// The description on the event page
-	Description string `json:"series_parent.description.html"`
+	Description string `json:"series_parent.description.html,omitempty"`
 	// of the organizer of this event
-	OrganizerID string `json:"series_parent.organizer_id"`
+	OrganizerID string `json:"series_parent.organizer_id,omitempty"`
 	// The start time of the event
 	StartUtc DateTime `json:"series_parent.start.utc" validate:"required"`
 	// Start time timezone (Olson format)
@@ -22,35 +22,35 @@ type SeriesCreateEventRequest struct {
 	// End time timezone (Olson format)
 	EndTimezone string `json:"series_parent.end.timezone" validate:"required"`
 	// Whether the start date should be hidden
-	HideStartDate bool `json:"series_parent.hide_start_date"`
+	HideStartDate *bool `json:"series_parent.hide_start_date,omitempty"`
 	// Whether the end date should be hidden
-	HideEndDate bool `json:"series_parent.hide_end_date"`
+	HideEndDate *bool `json:"series_parent.hide_end_date,omitempty"`
 	// Event currency (3 letter code)
 	Currency string `json:"series_parent.currency" validate:"required"`
 	// ID of the venue
-	VenueID string `json:"series_parent.venue_id"`
+	VenueID string `json:"series_parent.venue_id,omitempty"`
 	// Is the event online-only (no venue)?
-	OnlineEvent bool `json:"series_parent.online_event"`
+	OnlineEvent *bool `json:"series_parent.online_event,omitempty"`
 	// If the event is publicly listed and searchable. Defaults to true
-	Listed bool `json:"series_parent.listed"`
+	Listed *bool `json:"series_parent.listed,omitempty"`
 	// (Deprecated) The logo for the event
-	LogoID string `json:"series_parent.logo.id"`
+	LogoID string `json:"series_parent.logo.id,omitempty"`
 	// The category (vertical) of the event
-	CategoryID string `json:"series_parent.category_id"`
+	CategoryID string `json:"series_parent.category_id,omitempty"`
 	// The subcategory of the event (US only)
-	SubCategoryID string `json:"series_parent.subcategory_id"`
+	SubCategoryID string `json:"series_parent.subcategory_id,omitempty"`
 	// The format (general type) of the event
-	FormatID string `json:"series_parent.format_id"`
+	FormatID string `json:"series_parent.format_id,omitempty"`
 	// If users can share the event on social media
-	Sharable bool `json:"series_parent.shareable"`
+	Sharable *bool `json:"series_parent.shareable,omitempty"`
 	// Only invited users can see the event page
-	InviteOnly bool `json:"series_parent.invite_only"`
+	InviteOnly *bool `json:"series_parent.invite_only,omitempty"`
 	// Password needed to see the event in unlisted mode
-	Password string `json:"series_parent.password"`
+	Password string `json:"series_parent.password,omitempty"`
 	// Set specific capacity (if omitted, sums ticket capacities)
-	Capacity int `json:"series_parent.capacity"`
+	Capacity int `json:"series_parent.capacity,omitempty"`
 	// If the remaining number of tickets is publicly visible on the event page
-	ShowRemaining bool `json:"series_parent.show_remaining"`
+	ShowRemaining *bool `json:"series_parent.show_remaining,omitempty"`
 	// A list of dates for which child events should be created. In the format:
 	// [
 	//   {
@@ -66,7 +66,23 @@ type SeriesCreateEventRequest struct {
 
 type ObjectList []interface{}
 
-// SeriesEventRequest is the response structure for series event
+// Series is the parent event of a repeating event series. Its occurrences are the events with
+// its SeriesID.
+//
+// https://www.eventbrite.co.uk/developer/v3/endpoints/events_series/
+type Series struct {
+	Event
+}
+
+// SeriesEventsResult is the response structure for the occurrences of a series
+type SeriesEventsResult struct {
+	RawFields
+
+	Pagination Pagination `json:"pagination"`
+	Events     []Event    `json:"events"`
+}
+
+// SeriesEventRequest is the request structure to get the events of a series
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/events_series/#ebapi-id14
 type SeriesEventRequest struct {
@@ -95,7 +111,7 @@ type SeriesCUREventRequest struct {
 	//  ]
 	//
 	// https://www.eventbrite.co.uk/developer/v3/response_formats/basic/#ebapi-std:format-objectlist
-	CreateChildren interface{} `json:"create_children"`
+	CreateChildren interface{} `json:"create_children,omitempty"`
 	// A map of event IDs to modified date objects for updating child events. In the format:
 	//
 	// {
@@ -104,32 +120,45 @@ type SeriesCUREventRequest struct {
 	//   "5678": { ... },
 	//   ...
 	// }
-	UpdateChildren interface{} `json:"create_children"`
+	UpdateChildren interface{} `json:"update_children,omitempty"`
 	// A list of IDs for child events that should be deleted. In the format: 1234,5678,9012
-	DeleteChildren []string `json:"delete_children"`
+	DeleteChildren []string `json:"delete_children,omitempty"`
 }
 
 // EventSeriesCreate creates a new repeating event series. The POST data must include information for at
 // least one event date in the series.
 //
-// Return object is not documented
-//
 // https://www.eventbrite.co.uk/developer/v3/endpoints/events_series/#ebapi-post-series
-func (c *Client) EventSeriesCreate(ctx context.Context, req *SeriesCreateEventRequest) (interface{}, error) {
-	var resp interface{}
+func (c *Client) EventSeriesCreate(ctx context.Context, req *SeriesCreateEventRequest) (*Series, error) {
+	resp := new(Series)
 
-	return resp, c.postJSON(ctx, "/series/", req, &resp)
+	return resp, c.postJSON(ctx, "/series/", req, resp)
 }
 
 // EventSeriesGet returns a repeating event series parent object for the specified repeating event series
 //
-// Return object is not documented
-//
 // https://www.eventbrite.co.uk/developer/v3/endpoints/events_series/#ebapi-get-series-id
-func (c *Client) EventSeriesGet(ctx context.Context, id string) (interface{}, error) {
-	var resp interface{}
+func (c *Client) EventSeriesGet(ctx context.Context, id string) (*Series, error) {
+	resp := new(Series)
 
-	return resp, c.getJSON(ctx, fmt.Sprintf("/series/%s", id), nil, &resp)
+	return resp, c.getJSON(ctx, fmt.Sprintf("/series/%s", id), nil, resp)
+}
+
+// EventSeriesEvents returns all of the events that belong to this repeating event series
+//
+// https://www.eventbrite.co.uk/developer/v3/endpoints/events_series/#ebapi-get-series-id-events
+func (c *Client) EventSeriesEvents(ctx context.Context, id string, req *SeriesEventRequest) (*SeriesEventsResult, error) {
+	v := new(SeriesEventsResult)
+
+	return v, c.getJSON(ctx, fmt.Sprintf("/series/%s/events/", id), req, v)
+}
+
+// EventSeriesEventsIterator returns an iterator walking all the pages of EventSeriesEvents
+func (c *Client) EventSeriesEventsIterator(ctx context.Context, id string, req *SeriesEventRequest) *Iterator[Event] {
+	return newIterator(ctx, func(ctx context.Context) ([]Event, Pagination, error) {
+		r, err := c.EventSeriesEvents(ctx, id, req)
+		return r.Events, r.Pagination, err
+	})
 }
 
 // Publishes a repeating event series and all of its occurrences that are not already canceled or deleted.
@@ -140,11 +169,11 @@ func (c *Client) EventSeriesGet(ctx context.Context, id string) (interface{}, er
 // validate the publish requirements. Returns a boolean indicating success or failure of the publish
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/events_series/#ebapi-post-series-id-publish
-func (c *Client) EventSeriesPublish(ctx context.Context, id string) (interface{}, error) {
+func (c *Client) EventSeriesPublish(ctx context.Context, id string) (*PublishResult, error) {
 	path := fmt.Sprintf("/series/%s/publish", id)
 
-	var resp interface{}
-	return resp, c.postJSON(ctx, path, nil, &resp)
+	resp := new(PublishResult)
+	return resp, c.postJSON(ctx, path, nil, resp)
 }
 
 // Unpublishes a repeating event series and all of its occurrences that are not already completed, canceled,
@@ -154,11 +183,11 @@ func (c *Client) EventSeriesPublish(ctx context.Context, id string) (interface{}
 // paid out do not prevent an unpublish. Returns a boolean indicating success or failure of the unpublish
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/events_series/#ebapi-post-series-id-unpublish
-func (c *Client) EventSeriesUnPublish(ctx context.Context, id string) (interface{}, error) {
+func (c *Client) EventSeriesUnPublish(ctx context.Context, id string) (*UnpublishResult, error) {
 	path := fmt.Sprintf("/series/%s/unpublish", id)
 
-	var resp interface{}
-	return resp, c.postJSON(ctx, path, nil, &resp)
+	resp := new(UnpublishResult)
+	return resp, c.postJSON(ctx, path, nil, resp)
 }
 
 // Cancels a repeating event series and all of its occurrences that are not already canceled or deleted. In order
@@ -166,11 +195,11 @@ func (c *Client) EventSeriesUnPublish(ctx context.Context, id string) (interface
 // a boolean indicating success or failure of the cancel
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/events_series/#ebapi-post-series-id-cancel
-func (c *Client) EventSeriesCancel(ctx context.Context, id string) (interface{}, error) {
-	path := fmt.Sprintf("/series/%s/unpublish", id)
+func (c *Client) EventSeriesCancel(ctx context.Context, id string) (*CancelResult, error) {
+	path := fmt.Sprintf("/series/%s/cancel", id)
 
-	var resp interface{}
-	return resp, c.postJSON(ctx, path, nil, &resp)
+	resp := new(CancelResult)
+	return resp, c.postJSON(ctx, path, nil, resp)
 }
 
 // Deletes a repeating event series and all of its occurrences if the delete is permitted. In order for a delete to
@@ -178,19 +207,21 @@ func (c *Client) EventSeriesCancel(ctx context.Context, id string) (interface{},
 // indicating success or failure of the delete
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/events_series/#ebapi-delete-series-id
-func (c *Client) EventSeriesDelete(ctx context.Context, id string) (interface{}, error) {
+func (c *Client) EventSeriesDelete(ctx context.Context, id string) (*DeleteResult, error) {
 	path := fmt.Sprintf("/series/%s", id)
 
-	var resp interface{}
-	return resp, c.deleteJSON(ctx, path, &resp)
+	resp := new(DeleteResult)
+	return resp, c.deleteJSON(ctx, path, resp)
 }
 
 // Creates more event dates or updates or deletes existing event dates in a repeating event series. In order for a
-// series date to be deleted or updated, there must be no pending or completed orders for that date
+// series date to be deleted or updated, there must be no pending or completed orders for that date.
+// It has no iterator as fetching a next page would send the changes again: use
+// EventSeriesEventsIterator to walk the events of the series.
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/events_series/#ebapi-post-series-id-events
-func (c *Client) EventSeriesCUD(ctx context.Context, id string, req *SeriesCUREventRequest) (interface{}, error) {
-	var v interface{}
+func (c *Client) EventSeriesCUD(ctx context.Context, id string, req *SeriesCUREventRequest) (*SeriesEventsResult, error) {
+	v := new(SeriesEventsResult)
 
 	return v, c.postJSON(ctx, fmt.Sprintf("/series/%s/events/", id), req, v)
 }
@@ -0,0 +1,47 @@
+package eventbrite_test
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/apzuk/go-eventbrite"
+	"github.com/apzuk/go-eventbrite/eventbritetest"
+)
+
+func TestEventGet(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []eventbrite.ClientOption
+	}{
+		{"uncached", nil},
+		{"cached", []eventbrite.ClientOption{eventbrite.WithCache(eventbrite.NewLRUCache(10), nil)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := eventbritetest.NewServer()
+			defer srv.Close()
+
+			id := srv.AddEvent(eventbrite.Event{Name: eventbrite.MultipartText{Html: "Gophercon"}})
+			client, err := srv.Client(tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+
+			event, err := client.EventGet(context.Background(), id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if event.Id != id || event.Name.Html != "Gophercon" {
+				t.Errorf("got event %s %q, want %s %q", event.Id, event.Name.Html, id, "Gophercon")
+			}
+
+			if _, err := client.EventGet(context.Background(), "404"); !errors.Is(err, eventbrite.ErrNotFound) {
+				t.Errorf("got error %v for an unknown event, want ErrNotFound", err)
+			}
+		})
+	}
+}
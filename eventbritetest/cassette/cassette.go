@@ -0,0 +1,408 @@
+// Package cassette records the HTTP interactions of an Eventbrite client to a file and replays
+// them, so tests of code built on the client are deterministic and run offline.
+//
+//	rec, err := cassette.New("testdata/orders.json")
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//
+//	client, err := eventbrite.NewClient(eventbrite.WithToken(token), eventbrite.WithHTTPClient(rec.HTTPClient()))
+//
+// Credentials and email addresses are scrubbed before interactions are written: the headers
+// carrying credentials or cookies, and the query parameters, form fields and JSON fields whose
+// name mentions a token, a secret or a password, e.g. access_token or client_secret.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Mode decides whether a Recorder records or replays interactions
+type Mode int
+
+const (
+	// ModeAuto replays the cassette if its file exists, and records it otherwise
+	ModeAuto Mode = iota
+	// ModeRecord sends the requests to the API and records them, overwriting the cassette
+	ModeRecord
+	// ModeReplay answers the requests from the cassette only
+	ModeReplay
+)
+
+// ErrUnmatched is returned in strict mode for a request matching no interaction of the cassette
+var ErrUnmatched = errors.New("cassette: no interaction matches the request")
+
+const redacted = "[REDACTED]"
+
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// sensitiveHeaders are the request and response headers left out of the cassette
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "Www-Authenticate"}
+
+// sensitiveNames are the words of the query parameters and body fields whose value is redacted
+var sensitiveNames = []string{"token", "secret", "password"}
+
+// Request is a recorded request
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is a recorded response
+type Response struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Interaction is a request and the response it got
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+
+	replayed bool
+}
+
+// Matcher reports whether a recorded request matches the request being sent. Both are scrubbed.
+type Matcher func(r Request, recorded Request) bool
+
+// MatchMethod matches requests of the same method
+func MatchMethod(r Request, recorded Request) bool {
+	return r.Method == recorded.Method
+}
+
+// MatchPath matches requests to the same path
+func MatchPath(r Request, recorded Request) bool {
+	u, err1 := url.Parse(r.URL)
+	v, err2 := url.Parse(recorded.URL)
+	return err1 == nil && err2 == nil && u.Path == v.Path
+}
+
+// MatchQuery matches requests with the same query parameters, in any order
+func MatchQuery(r Request, recorded Request) bool {
+	u, err1 := url.Parse(r.URL)
+	v, err2 := url.Parse(recorded.URL)
+	return err1 == nil && err2 == nil && u.Query().Encode() == v.Query().Encode()
+}
+
+// MatchBody matches requests with the same body
+func MatchBody(r Request, recorded Request) bool {
+	return r.Body == recorded.Body
+}
+
+// DefaultMatchers match requests by method, path and query
+var DefaultMatchers = []Matcher{MatchMethod, MatchPath, MatchQuery}
+
+// Option configures a Recorder
+type Option func(*Recorder)
+
+// WithMode sets the mode of the recorder. Default is ModeAuto.
+func WithMode(m Mode) Option {
+	return func(r *Recorder) {
+		r.mode = m
+	}
+}
+
+// WithMatchers sets the matchers a recorded request must all satisfy to be replayed. Default
+// is DefaultMatchers.
+func WithMatchers(m ...Matcher) Option {
+	return func(r *Recorder) {
+		r.matchers = m
+	}
+}
+
+// Strict makes a replaying recorder fail with ErrUnmatched on requests matching no interaction,
+// or only interactions already replayed. Otherwise requests matching no interaction are sent
+// to the API, and the last matching interaction is replayed again once all were.
+func Strict() Option {
+	return func(r *Recorder) {
+		r.strict = true
+	}
+}
+
+// WithTransport sets the transport sending the requests that are not replayed. Default is
+// http.DefaultTransport.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = rt
+	}
+}
+
+// Recorder is a http.RoundTripper recording or replaying the interactions of a cassette file
+type Recorder struct {
+	path      string
+	mode      Mode
+	matchers  []Matcher
+	strict    bool
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	recorded     bool
+}
+
+// New returns a recorder for the cassette stored at path
+func New(path string, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		matchers:  DefaultMatchers,
+		transport: http.DefaultTransport,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == ModeAuto {
+		r.mode = ModeReplay
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			r.mode = ModeRecord
+		}
+	}
+	if r.mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var c struct {
+			Interactions []*Interaction `json:"interactions"`
+		}
+		if err := json.Unmarshal(data, &c); err != nil {
+			return nil, fmt.Errorf("cassette: %s: %v", path, err)
+		}
+		r.interactions = c.Interactions
+	}
+
+	return r, nil
+}
+
+// HTTPClient returns a client sending its requests through the recorder, to be passed to
+// eventbrite.WithHTTPClient
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	scrubbed := scrubRequest(req, body)
+
+	if r.mode == ModeReplay {
+		if i := r.match(scrubbed); i != nil {
+			return i.Response.httpResponse(req), nil
+		}
+		if r.strict {
+			return nil, fmt.Errorf("%w: %s %s", ErrUnmatched, scrubbed.Method, scrubbed.URL)
+		}
+		return r.transport.RoundTrip(req)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, &Interaction{
+		Request: scrubbed,
+		Response: Response{
+			Status: resp.StatusCode,
+			Header: scrubHeader(resp.Header),
+			Body:   scrubBody(resp.Header, respBody),
+		},
+	})
+	r.recorded = true
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// match returns the first interaction not yet replayed matching the request. Outside of strict
+// mode the last matching interaction is replayed again once all were.
+func (r *Recorder) match(req Request) *Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var last *Interaction
+	for _, i := range r.interactions {
+		if !r.matches(req, i.Request) {
+			continue
+		}
+		if !i.replayed {
+			i.replayed = true
+			return i
+		}
+		last = i
+	}
+	if r.strict {
+		return nil
+	}
+	return last
+}
+
+func (r *Recorder) matches(req, recorded Request) bool {
+	for _, m := range r.matchers {
+		if !m(req, recorded) {
+			return false
+		}
+	}
+	return true
+}
+
+// Stop writes the recorded interactions to the cassette file. It does nothing when replaying.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.recorded {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(struct {
+		Interactions []*Interaction `json:"interactions"`
+	}{r.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, data, 0644)
+}
+
+func (resp Response) httpResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.Status, http.StatusText(resp.Status)),
+		StatusCode:    resp.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        resp.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(resp.Body)),
+		ContentLength: int64(len(resp.Body)),
+		Request:       req,
+	}
+}
+
+// scrubRequest returns the request as recorded: without credentials and email addresses
+func scrubRequest(req *http.Request, body []byte) Request {
+	u := *req.URL
+	q := u.Query()
+	scrubValues(q)
+	u.RawQuery = q.Encode()
+
+	return Request{
+		Method: req.Method,
+		URL:    scrub(u.String()),
+		Header: scrubHeader(req.Header),
+		Body:   scrubBody(req.Header, body),
+	}
+}
+
+// scrubHeader returns a copy of the header without the headers carrying credentials
+func scrubHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range sensitiveHeaders {
+		h.Del(k)
+	}
+	return h
+}
+
+// scrubBody returns the body as recorded, redacting the sensitive fields of a JSON or form
+// encoded body
+func scrubBody(h http.Header, body []byte) string {
+	if strings.HasPrefix(h.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			scrubValues(form)
+			return form.Encode()
+		}
+	}
+
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	if d.Decode(&v) == nil && scrubJSON(v) {
+		if data, err := json.Marshal(v); err == nil {
+			body = data
+		}
+	}
+	return scrub(string(body))
+}
+
+// scrubJSON redacts the sensitive fields of the decoded JSON value, reporting whether it did
+func scrubJSON(v interface{}) bool {
+	found := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			if sensitive(k) {
+				v[k] = redacted
+				found = true
+			} else if scrubJSON(field) {
+				found = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if scrubJSON(item) {
+				found = true
+			}
+		}
+	}
+	return found
+}
+
+// scrubValues redacts the sensitive values of a query or form, and the email addresses of the others
+func scrubValues(values url.Values) {
+	for k, vs := range values {
+		if sensitive(k) {
+			values[k] = []string{redacted}
+			continue
+		}
+		for i := range vs {
+			vs[i] = scrub(vs[i])
+		}
+	}
+}
+
+// sensitive reports whether the value of the named parameter or field is a credential
+func sensitive(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range sensitiveNames {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func scrub(s string) string {
+	return emailPattern.ReplaceAllString(s, redacted)
+}
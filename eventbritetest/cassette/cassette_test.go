@@ -0,0 +1,209 @@
+package cassette
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/apzuk/go-eventbrite"
+)
+
+// secrets are the credentials sent to and returned by the stub server, none of which may be recorded
+var secrets = []string{
+	"client-token", "query-token", "client-secret", "user-password", "session-cookie", "request-cookie",
+	"response-access-token", "response-refresh-token", "nested-token", "organizer@example.com",
+}
+
+// stubServer answers every request with cookies and credentials in its headers and body
+func stubServer(t *testing.T, hits *int) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*hits++
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "session-cookie"})
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "1", "name": "Organizer", "emails": [{"email": "organizer@example.com"}],
+			"access_token": "response-access-token", "refresh_token": "response-refresh-token",
+			"integrations": [{"api": {"Token": "nested-token"}}]}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRecordScrubsSecrets(t *testing.T) {
+	var hits int
+	srv := stubServer(t, &hits)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	rec, err := New(path, WithMode(ModeRecord))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := eventbrite.NewClient(eventbrite.WithToken("client-token"), eventbrite.WithBaseURL(srv.URL),
+		eventbrite.WithHTTPClient(rec.HTTPClient()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	// the caller still gets the response as sent
+	user, err := client.User(context.Background(), "me")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(user.Emails) != 1 || user.Emails[0].Email != "organizer@example.com" {
+		t.Errorf("got emails %v, want the unscrubbed address", user.Emails)
+	}
+
+	requests := []struct {
+		url, contentType, body string
+	}{
+		{srv.URL + "/oauth/token?token=query-token", "application/x-www-form-urlencoded",
+			"grant_type=authorization_code&client_secret=client-secret&code=abc"},
+		{srv.URL + "/users/", "application/json",
+			`{"user": {"email": "organizer@example.com", "password": "user-password"}}`},
+	}
+	for _, r := range requests {
+		req, err := http.NewRequest(http.MethodPost, r.url, strings.NewReader(r.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", r.contentType)
+		req.Header.Set("Cookie", "session=request-cookie")
+		resp, err := rec.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !strings.Contains(string(body), "response-access-token") {
+			t.Errorf("got response %s, want the unscrubbed body", body)
+		}
+	}
+
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range secrets {
+		if strings.Contains(string(data), s) {
+			t.Errorf("cassette contains %q:\n%s", s, data)
+		}
+	}
+	if !strings.Contains(string(data), redacted) {
+		t.Errorf("cassette has no redacted value:\n%s", data)
+	}
+	if hits != 3 {
+		t.Errorf("got %d requests to the server, want 3", hits)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	var hits int
+	srv := stubServer(t, &hits)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	// without retries, which would replay an unmatched request again
+	newClient := func(rec *Recorder) *eventbrite.Client {
+		client, err := eventbrite.NewClient(eventbrite.WithToken("client-token"), eventbrite.WithBaseURL(srv.URL),
+			eventbrite.WithHTTPClient(rec.HTTPClient()), eventbrite.WithRetryPolicy(eventbrite.RetryPolicy{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { client.Close() })
+		return client
+	}
+
+	// the cassette does not exist yet: ModeAuto records it
+	rec, err := New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newClient(rec).User(context.Background(), "me"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err = New(path, Strict())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newClient(rec)
+	user, err := client.User(context.Background(), "me")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Name != "Organizer" {
+		t.Errorf("got user %q, want %q", user.Name, "Organizer")
+	}
+	if hits != 1 {
+		t.Errorf("got %d requests to the server, want the 1 recorded", hits)
+	}
+
+	if _, err := client.User(context.Background(), "me"); !errors.Is(err, ErrUnmatched) {
+		t.Errorf("got error %v replaying the interaction twice, want ErrUnmatched", err)
+	}
+	if _, err := client.User(context.Background(), "2"); !errors.Is(err, ErrUnmatched) {
+		t.Errorf("got error %v for an unrecorded request, want ErrUnmatched", err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMatchers(t *testing.T) {
+	recorded := Request{Method: http.MethodGet, URL: "https://api.example.com/events/?a=1&b=2", Body: "{}"}
+	tests := []struct {
+		name    string
+		matcher Matcher
+		r       Request
+		want    bool
+	}{
+		{"same method", MatchMethod, Request{Method: http.MethodGet}, true},
+		{"other method", MatchMethod, Request{Method: http.MethodPost}, false},
+		{"same path", MatchPath, Request{URL: "https://api.example.com/events/?c=3"}, true},
+		{"other path", MatchPath, Request{URL: "https://api.example.com/venues/"}, false},
+		{"same query in another order", MatchQuery, Request{URL: "https://api.example.com/?b=2&a=1"}, true},
+		{"other query", MatchQuery, Request{URL: "https://api.example.com/events/?a=1"}, false},
+		{"same body", MatchBody, Request{Body: "{}"}, true},
+		{"other body", MatchBody, Request{Body: `{"a": 1}`}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.matcher(tt.r, recorded); got != tt.want {
+			t.Errorf("%s: got %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestScrubBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{"json", "application/json", `{"access_token": "x", "name": "y"}`, `{"access_token":"[REDACTED]","name":"y"}`},
+		{"json unchanged", "application/json", `{"name": "y"}`, `{"name": "y"}`},
+		{"form", "application/x-www-form-urlencoded", "client_secret=x&code=y", "client_secret=%5BREDACTED%5D&code=y"},
+		{"email", "text/plain", "to bob@example.com", "to [REDACTED]"},
+		{"empty", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		h := http.Header{"Content-Type": {tt.contentType}}
+		if got := scrubBody(h, []byte(tt.body)); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
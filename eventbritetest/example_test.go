@@ -0,0 +1,29 @@
+package eventbritetest_test
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"github.com/apzuk/go-eventbrite"
+	"github.com/apzuk/go-eventbrite/eventbritetest"
+)
+
+func Example() {
+	srv := eventbritetest.NewServer()
+	defer srv.Close()
+
+	id := srv.AddEvent(eventbrite.Event{Name: eventbrite.MultipartText{Html: "Gophercon"}})
+	client, err := srv.Client()
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+
+	event, err := client.EventGet(context.Background(), id)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(event.Name.Html, event.Status)
+	// Output: Gophercon live
+}
@@ -0,0 +1,603 @@
+package eventbritetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// routeTable lists the endpoints covered by the client. Patterns are matched in order.
+func (s *Server) routeTable() []route {
+	routes := []struct {
+		method, pattern string
+		handle          func(w http.ResponseWriter, r *request)
+	}{
+		{http.MethodGet, "/events/search/", s.searchEvents},
+		{http.MethodPost, "/events/", s.createEvent},
+		{http.MethodGet, "/events/:id/", s.getEvent},
+		{http.MethodPost, "/events/:id/", s.updateEvent},
+		{http.MethodDelete, "/events/:id/", s.deleteEvent},
+		{http.MethodPost, "/events/:id/publish/", s.setEventStatus("live", "published")},
+		{http.MethodPost, "/events/:id/unpublish/", s.setEventStatus("draft", "unpublished")},
+		{http.MethodPost, "/events/:id/cancel/", s.setEventStatus("canceled", "canceled")},
+		{http.MethodGet, "/events/:id/ticket_classes/", s.listTicketClasses},
+		{http.MethodPost, "/events/:id/ticket_classes/", s.createTicketClass},
+		{http.MethodGet, "/events/:id/ticket_classes/:ticket_class_id/", s.getTicketClass},
+		{http.MethodPost, "/events/:id/ticket_classes/:ticket_class_id/", s.updateTicketClass},
+		{http.MethodDelete, "/events/:id/ticket_classes/:ticket_class_id/", s.deleteTicketClass},
+
+		{http.MethodPost, "/series/", s.createSeries},
+		{http.MethodGet, "/series/:id/", s.getSeries},
+		{http.MethodDelete, "/series/:id/", s.deleteSeries},
+		{http.MethodPost, "/series/:id/publish/", s.setSeriesStatus("live", "published")},
+		{http.MethodPost, "/series/:id/unpublish/", s.setSeriesStatus("draft", "unpublished")},
+		{http.MethodPost, "/series/:id/cancel/", s.setSeriesStatus("canceled", "canceled")},
+		{http.MethodGet, "/series/:id/events/", s.listSeriesEvents},
+		{http.MethodPost, "/series/:id/events/", s.updateSeriesEvents},
+
+		{http.MethodGet, "/orders/:id/", s.getOrder},
+
+		{http.MethodPost, "/venues/", s.createVenue},
+		{http.MethodGet, "/venues/:id/", s.getVenue},
+		{http.MethodPost, "/venues/:id/", s.updateVenue},
+		{http.MethodGet, "/venues/:id/events/", s.listEventsBy("venue_id", Venues, "venue")},
+
+		{http.MethodPost, "/organizers/", s.createOrganizer},
+		{http.MethodGet, "/organizers/:id/", s.getOrganizer},
+		{http.MethodPost, "/organizers/:id/", s.updateOrganizer},
+		{http.MethodGet, "/organizers/:id/events/", s.listEventsBy("organizer_id", Organizers, "organizer")},
+
+		{http.MethodGet, "/webhooks/", s.listWebhooks},
+		{http.MethodPost, "/webhooks/", s.createWebhook},
+		{http.MethodGet, "/webhooks/:id/", s.getObject(Webhooks, "webhook")},
+		{http.MethodDelete, "/webhooks/:id/", s.deleteObject(Webhooks, "webhook")},
+
+		{http.MethodPost, "/discounts/", s.createDiscount},
+		{http.MethodGet, "/discounts/:id/", s.getObject(Discounts, "discount")},
+		{http.MethodPost, "/discounts/:id/", s.updateDiscount},
+		{http.MethodDelete, "/discounts/:id/", s.deleteObject(Discounts, "discount")},
+
+		{http.MethodPost, "/ticket_groups/", s.createTicketGroup},
+		{http.MethodGet, "/ticket_groups/:id/", s.getObject(TicketGroups, "ticket group")},
+		{http.MethodPost, "/ticket_groups/:id/", s.updateTicketGroup},
+		{http.MethodDelete, "/ticket_groups/:id/", s.deleteTicketGroup},
+
+		{http.MethodGet, "/users/:user_id/", s.getUser},
+		{http.MethodGet, "/users/:user_id/owned_events/", s.listOwned(Events, "status")},
+		{http.MethodGet, "/users/:user_id/orders/", s.listOwned(Orders, "status")},
+		{http.MethodGet, "/users/:user_id/owned_event_orders/", s.listOwned(Orders, "status")},
+		{http.MethodGet, "/users/:user_id/owned_event_attendees/", s.listOwned(Attendees, "status")},
+		{http.MethodGet, "/users/:user_id/organizers/", s.listOwned(Organizers, "")},
+		{http.MethodGet, "/users/:user_id/venues/", s.listOwned(Venues, "")},
+	}
+
+	res := make([]route, len(routes))
+	for i, rt := range routes {
+		res[i] = route{method: rt.method, segments: splitPath(rt.pattern), handle: rt.handle}
+	}
+	return res
+}
+
+// find returns the object of the resource with the id of the request path, or writes the
+// NOT_FOUND error
+func (s *Server) find(w http.ResponseWriter, r Resource, kind, id string) (object, bool) {
+	o, ok := s.store.collections[r].get(id)
+	if !ok {
+		writeNotFound(w, kind, id)
+	}
+	return o, ok
+}
+
+func (s *Server) getObject(res Resource, kind string) func(w http.ResponseWriter, r *request) {
+	return func(w http.ResponseWriter, r *request) {
+		if o, ok := s.find(w, res, kind, r.vars["id"]); ok {
+			writeJSON(w, http.StatusOK, s.expand(r, res, o))
+		}
+	}
+}
+
+func (s *Server) deleteObject(res Resource, kind string) func(w http.ResponseWriter, r *request) {
+	return func(w http.ResponseWriter, r *request) {
+		if _, ok := s.find(w, res, kind, r.vars["id"]); ok {
+			s.store.collections[res].remove(r.vars["id"])
+			writeJSON(w, http.StatusOK, map[string]bool{"deleted": true})
+		}
+	}
+}
+
+// create validates the required parameters and stores the object built from the parameters
+// under prefix
+func (s *Server) create(w http.ResponseWriter, r *request, res Resource, prefix string, required []string, defaults object) (object, bool) {
+	if errs := r.params.missing(required...); len(errs) > 0 {
+		writeArgumentsError(w, errs)
+		return nil, false
+	}
+	o := object{}
+	merge(o, defaults)
+	merge(o, r.params.object(prefix))
+	normalize(res, o)
+	s.store.add(res, o)
+
+	return o, true
+}
+
+// update merges the non empty parameters under prefix into the stored object
+func (s *Server) update(w http.ResponseWriter, r *request, res Resource, prefix, kind, id string) (object, bool) {
+	o, ok := s.find(w, res, kind, id)
+	if !ok {
+		return nil, false
+	}
+	merge(o, r.params.object(prefix))
+	normalize(res, o)
+	o["changed"] = time.Now().UTC().Format(timeFormat)
+
+	return o, true
+}
+
+// normalize converts the parameters of a request to the types of the response objects
+func normalize(res Resource, o object) {
+	switch res {
+	case Events:
+		if name, ok := o["name"].(map[string]interface{}); ok && isEmpty(name["text"]) {
+			name["text"] = name["html"]
+		}
+		for _, key := range []string{"start", "end"} {
+			if t, ok := o[key].(map[string]interface{}); ok {
+				t["local"] = localTime(t)
+			}
+		}
+	case Venues:
+		if addr, ok := o["address"].(map[string]interface{}); ok {
+			for _, key := range []string{"latitude", "longitude"} {
+				if n, ok := addr[key].(json.Number); ok {
+					addr[key] = n.String()
+				}
+			}
+		}
+	}
+}
+
+// localTime returns the local time of a date with a timezone, in the format of the API
+func localTime(t map[string]interface{}) string {
+	utc, err := time.Parse(timeFormat, fmt.Sprint(t["utc"]))
+	if err != nil {
+		return ""
+	}
+	if loc, err := time.LoadLocation(fmt.Sprint(t["timezone"])); err == nil {
+		utc = utc.In(loc)
+	}
+	return utc.Format("2006-01-02T15:04:05")
+}
+
+// expand embeds the objects requested by the expand query parameter
+func (s *Server) expand(r *request, res Resource, o object) object {
+	expansions := r.URL.Query().Get("expand")
+	if expansions == "" {
+		return o
+	}
+
+	embed := func(key string, from Resource, id interface{}) {
+		if ref, ok := id.(string); ok {
+			if v, ok := s.store.collections[from].get(ref); ok {
+				o[key] = v
+			}
+		}
+	}
+	expanded := object{}
+	merge(expanded, o)
+	o = expanded
+	for _, e := range strings.Split(expansions, ",") {
+		switch {
+		case e == "venue" && res == Events:
+			embed("venue", Venues, o["venue_id"])
+		case e == "organizer" && res == Events:
+			embed("organizer", Organizers, o["organizer_id"])
+		case e == "ticket_classes" && res == Events:
+			o["ticket_classes"] = s.store.collections[TicketClasses].list(field("event_id", o["id"]))
+		case e == "event" && (res == Orders || res == Attendees || res == TicketClasses):
+			embed("event", Events, o["event_id"])
+		case e == "order" && res == Attendees:
+			embed("order", Orders, o["order_id"])
+		case e == "attendees" && res == Orders:
+			o["attendees"] = s.store.collections[Attendees].list(field("order_id", o["id"]))
+		}
+	}
+	return o
+}
+
+func (s *Server) expandAll(r *request, res Resource, items []object) []object {
+	expanded := make([]object, len(items))
+	for i, o := range items {
+		expanded[i] = s.expand(r, res, o)
+	}
+	return expanded
+}
+
+// field returns a filter keeping the objects whose key has the given value
+func field(key string, value interface{}) func(object) bool {
+	return func(o object) bool {
+		return o[key] == value
+	}
+}
+
+// statusIn returns a filter keeping the objects with one of the comma separated statuses,
+// or all of them when the list is empty or "all"
+func statusIn(list string) func(object) bool {
+	if list == "" || list == "all" {
+		return nil
+	}
+	return func(o object) bool {
+		for _, status := range strings.Split(list, ",") {
+			if o["status"] == status {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (s *Server) searchEvents(w http.ResponseWriter, r *request) {
+	q := strings.ToLower(r.URL.Query().Get("q"))
+	events := s.store.collections[Events].list(func(o object) bool {
+		if o["status"] != "live" || o["is_series_parent"] == true {
+			return false
+		}
+		name, _ := o["name"].(map[string]interface{})
+		return q == "" || strings.Contains(strings.ToLower(fmt.Sprint(name["text"], " ", name["html"])), q)
+	})
+	s.writePage(w, r, "events", s.expandAll(r, Events, events))
+}
+
+var eventParams = []string{"name.html", "start.utc", "start.timezone", "end.utc", "end.timezone", "currency"}
+
+func prefixed(prefix string, keys []string) []string {
+	res := make([]string, len(keys))
+	for i, k := range keys {
+		res[i] = prefix + "." + k
+	}
+	return res
+}
+
+func (s *Server) createEvent(w http.ResponseWriter, r *request) {
+	if o, ok := s.create(w, r, Events, "event", prefixed("event", eventParams), object{"status": "draft"}); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) getEvent(w http.ResponseWriter, r *request) {
+	if o, ok := s.find(w, Events, "event", r.vars["id"]); ok {
+		writeJSON(w, http.StatusOK, s.expand(r, Events, o))
+	}
+}
+
+func (s *Server) updateEvent(w http.ResponseWriter, r *request) {
+	if o, ok := s.update(w, r, Events, "event", "event", r.vars["id"]); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) deleteEvent(w http.ResponseWriter, r *request) {
+	id := r.vars["id"]
+	if _, ok := s.find(w, Events, "event", id); !ok {
+		return
+	}
+	s.store.collections[Events].remove(id)
+	for _, tc := range s.store.collections[TicketClasses].list(field("event_id", id)) {
+		s.store.collections[TicketClasses].remove(tc["id"].(string))
+	}
+	writeJSON(w, http.StatusOK, map[string]bool{"deleted": true})
+}
+
+func (s *Server) setEventStatus(status, result string) func(w http.ResponseWriter, r *request) {
+	return func(w http.ResponseWriter, r *request) {
+		if o, ok := s.find(w, Events, "event", r.vars["id"]); ok {
+			o["status"] = status
+			writeJSON(w, http.StatusOK, map[string]bool{result: true})
+		}
+	}
+}
+
+func (s *Server) listTicketClasses(w http.ResponseWriter, r *request) {
+	if _, ok := s.find(w, Events, "event", r.vars["id"]); ok {
+		classes := s.store.collections[TicketClasses].list(field("event_id", r.vars["id"]))
+		s.writePage(w, r, "ticket_classes", s.expandAll(r, TicketClasses, classes))
+	}
+}
+
+func (s *Server) createTicketClass(w http.ResponseWriter, r *request) {
+	if _, ok := s.find(w, Events, "event", r.vars["id"]); !ok {
+		return
+	}
+	defaults := object{"event_id": r.vars["id"], "quantity_sold": 0}
+	if o, ok := s.create(w, r, TicketClasses, "ticket_class", []string{"ticket_class.name"}, defaults); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+// ticketClass returns the ticket class of the request path, which must belong to its event
+func (s *Server) ticketClass(w http.ResponseWriter, r *request) (object, bool) {
+	o, ok := s.store.collections[TicketClasses].get(r.vars["ticket_class_id"])
+	if !ok || o["event_id"] != r.vars["id"] {
+		writeNotFound(w, "ticket class", r.vars["ticket_class_id"])
+		return nil, false
+	}
+	return o, true
+}
+
+func (s *Server) getTicketClass(w http.ResponseWriter, r *request) {
+	if o, ok := s.ticketClass(w, r); ok {
+		writeJSON(w, http.StatusOK, s.expand(r, TicketClasses, o))
+	}
+}
+
+func (s *Server) updateTicketClass(w http.ResponseWriter, r *request) {
+	if _, ok := s.ticketClass(w, r); !ok {
+		return
+	}
+	if o, ok := s.update(w, r, TicketClasses, "ticket_class", "ticket class", r.vars["ticket_class_id"]); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) deleteTicketClass(w http.ResponseWriter, r *request) {
+	if _, ok := s.ticketClass(w, r); ok {
+		s.store.collections[TicketClasses].remove(r.vars["ticket_class_id"])
+		writeJSON(w, http.StatusOK, map[string]bool{"deleted": true})
+	}
+}
+
+// series returns the parent event of the series of the request path
+func (s *Server) series(w http.ResponseWriter, r *request) (object, bool) {
+	o, ok := s.store.collections[Events].get(r.vars["id"])
+	if !ok || o["is_series_parent"] != true {
+		writeNotFound(w, "series", r.vars["id"])
+		return nil, false
+	}
+	return o, true
+}
+
+func (s *Server) seriesEvents(id string) []object {
+	return s.store.collections[Events].list(func(o object) bool {
+		return o["series_id"] == id && o["is_series_parent"] != true
+	})
+}
+
+func (s *Server) createSeries(w http.ResponseWriter, r *request) {
+	required := append(prefixed("series_parent", eventParams), "create_children")
+	defaults := object{"status": "draft", "is_series_parent": true}
+	parent, ok := s.create(w, r, Events, "series_parent", required, defaults)
+	if !ok {
+		return
+	}
+	parent["series_id"] = parent["id"]
+	s.addOccurrences(parent, r.params["create_children"])
+
+	writeJSON(w, http.StatusOK, parent)
+}
+
+// addOccurrences creates the events of a series from a list of dates in the format of the
+// create_children parameter
+func (s *Server) addOccurrences(parent object, dates interface{}) {
+	list, _ := dates.([]interface{})
+	for _, date := range list {
+		child := object{}
+		merge(child, toObject(parent))
+		delete(child, "id")
+		delete(child, "created")
+		delete(child, "is_series_parent")
+		child["is_series"] = true
+		if d, ok := date.(map[string]interface{}); ok {
+			merge(child, d)
+		}
+		normalize(Events, child)
+		s.store.add(Events, child)
+	}
+}
+
+func (s *Server) getSeries(w http.ResponseWriter, r *request) {
+	if o, ok := s.series(w, r); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) deleteSeries(w http.ResponseWriter, r *request) {
+	if _, ok := s.series(w, r); !ok {
+		return
+	}
+	for _, e := range s.seriesEvents(r.vars["id"]) {
+		s.store.collections[Events].remove(e["id"].(string))
+	}
+	s.store.collections[Events].remove(r.vars["id"])
+	writeJSON(w, http.StatusOK, map[string]bool{"deleted": true})
+}
+
+func (s *Server) setSeriesStatus(status, result string) func(w http.ResponseWriter, r *request) {
+	return func(w http.ResponseWriter, r *request) {
+		parent, ok := s.series(w, r)
+		if !ok {
+			return
+		}
+		parent["status"] = status
+		for _, e := range s.seriesEvents(r.vars["id"]) {
+			if e["status"] != "canceled" || status == "canceled" {
+				e["status"] = status
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]bool{result: true})
+	}
+}
+
+func (s *Server) listSeriesEvents(w http.ResponseWriter, r *request) {
+	if _, ok := s.series(w, r); ok {
+		s.writePage(w, r, "events", s.seriesEvents(r.vars["id"]))
+	}
+}
+
+func (s *Server) updateSeriesEvents(w http.ResponseWriter, r *request) {
+	parent, ok := s.series(w, r)
+	if !ok {
+		return
+	}
+	s.addOccurrences(parent, r.params["create_children"])
+	if ids, ok := r.params["delete_children"].([]interface{}); ok {
+		for _, id := range ids {
+			if e, ok := s.store.collections[Events].get(fmt.Sprint(id)); ok && e["series_id"] == parent["id"] {
+				s.store.collections[Events].remove(fmt.Sprint(id))
+			}
+		}
+	}
+	s.writePage(w, r, "events", s.seriesEvents(r.vars["id"]))
+}
+
+func (s *Server) getOrder(w http.ResponseWriter, r *request) {
+	if o, ok := s.find(w, Orders, "order", r.vars["id"]); ok {
+		writeJSON(w, http.StatusOK, s.expand(r, Orders, o))
+	}
+}
+
+func (s *Server) createVenue(w http.ResponseWriter, r *request) {
+	if o, ok := s.create(w, r, Venues, "venue", []string{"venue.name"}, nil); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) getVenue(w http.ResponseWriter, r *request) {
+	if o, ok := s.find(w, Venues, "venue", r.vars["id"]); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) updateVenue(w http.ResponseWriter, r *request) {
+	if o, ok := s.update(w, r, Venues, "venue", "venue", r.vars["id"]); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+// listEventsBy lists the events referencing the object of the request path through key
+func (s *Server) listEventsBy(key string, res Resource, kind string) func(w http.ResponseWriter, r *request) {
+	return func(w http.ResponseWriter, r *request) {
+		if _, ok := s.find(w, res, kind, r.vars["id"]); !ok {
+			return
+		}
+		status := statusIn(r.URL.Query().Get("status"))
+		events := s.store.collections[Events].list(func(o object) bool {
+			return o[key] == r.vars["id"] && (status == nil || status(o))
+		})
+		s.writePage(w, r, "events", s.expandAll(r, Events, events))
+	}
+}
+
+func (s *Server) createOrganizer(w http.ResponseWriter, r *request) {
+	if o, ok := s.create(w, r, Organizers, "organizer", []string{"organizer.name"}, nil); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) getOrganizer(w http.ResponseWriter, r *request) {
+	if o, ok := s.find(w, Organizers, "organizer", r.vars["id"]); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) updateOrganizer(w http.ResponseWriter, r *request) {
+	if o, ok := s.update(w, r, Organizers, "organizer", "organizer", r.vars["id"]); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) listWebhooks(w http.ResponseWriter, r *request) {
+	var keep func(object) bool
+	if org := r.URL.Query().Get("organization_id"); org != "" {
+		keep = field("organization_id", org)
+	}
+	s.writePage(w, r, "webhooks", s.store.collections[Webhooks].list(keep))
+}
+
+func (s *Server) createWebhook(w http.ResponseWriter, r *request) {
+	defaults := object{"actions": "order.placed,event.published,event.unpublished"}
+	if o, ok := s.create(w, r, Webhooks, "", []string{"endpoint_url"}, defaults); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+// discountTypes are the valid values of the type of a discount
+var discountTypes = map[string]bool{"access": true, "coded": true, "public": true, "hold": true}
+
+func (s *Server) validDiscount(w http.ResponseWriter, r *request) bool {
+	if t := r.params.str("discount.type"); t != "" && !discountTypes[t] {
+		writeArgumentsError(w, map[string][]string{"discount.type": {"INVALID"}})
+		return false
+	}
+	return true
+}
+
+func (s *Server) createDiscount(w http.ResponseWriter, r *request) {
+	if !s.validDiscount(w, r) {
+		return
+	}
+	defaults := object{"type": "coded", "quantity_sold": 0}
+	if o, ok := s.create(w, r, Discounts, "discount", []string{"discount.code"}, defaults); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) updateDiscount(w http.ResponseWriter, r *request) {
+	if !s.validDiscount(w, r) {
+		return
+	}
+	if o, ok := s.update(w, r, Discounts, "discount", "discount", r.vars["id"]); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) createTicketGroup(w http.ResponseWriter, r *request) {
+	if o, ok := s.create(w, r, TicketGroups, "ticket_group", []string{"ticket_group.name"}, object{"status": "live"}); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) updateTicketGroup(w http.ResponseWriter, r *request) {
+	if o, ok := s.update(w, r, TicketGroups, "ticket_group", "ticket group", r.vars["id"]); ok {
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+// deleteTicketGroup marks the ticket group as deleted, as the API keeps deleted groups
+func (s *Server) deleteTicketGroup(w http.ResponseWriter, r *request) {
+	if o, ok := s.find(w, TicketGroups, "ticket group", r.vars["id"]); ok {
+		o["status"] = "deleted"
+		writeJSON(w, http.StatusOK, map[string]bool{"deleted": true})
+	}
+}
+
+// user checks that the user of the request path is the user of the server
+func (s *Server) user(w http.ResponseWriter, r *request) bool {
+	if id := r.vars["user_id"]; id != UserID && id != "me" {
+		writeNotFound(w, "user", id)
+		return false
+	}
+	return true
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *request) {
+	if s.user(w, r) {
+		writeJSON(w, http.StatusOK, s.store.user)
+	}
+}
+
+// listOwned lists all objects of the resource, as the user of the server owns them all. The
+// listing is filtered by the statuses of the query parameter named filter, if any.
+func (s *Server) listOwned(res Resource, filter string) func(w http.ResponseWriter, r *request) {
+	return func(w http.ResponseWriter, r *request) {
+		if !s.user(w, r) {
+			return
+		}
+		var keep func(object) bool
+		if filter != "" {
+			keep = statusIn(r.URL.Query().Get(filter))
+		}
+		s.writePage(w, r, string(res), s.expandAll(r, res, s.store.collections[res].list(keep)))
+	}
+}
@@ -0,0 +1,55 @@
+package eventbritetest
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/apzuk/go-eventbrite"
+)
+
+// ErrNotMocked is returned by the operations of a Mock without a function
+var ErrNotMocked = errors.New("eventbritetest: operation not mocked")
+
+var _ eventbrite.Services = (*Mock)(nil)
+
+// MockCall is a call of an operation of a Mock
+type MockCall struct {
+	// The name of the operation, e.g. "EventGet"
+	Operation string
+	// The arguments of the call after the context
+	Args []interface{}
+}
+
+// recorder records the calls of a Mock
+type recorder struct {
+	mu    sync.Mutex
+	calls []MockCall
+}
+
+func (r *recorder) record(operation string, args []interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, MockCall{Operation: operation, Args: args})
+}
+
+// Calls returns the calls of the operations of the mock, in order
+func (r *recorder) Calls() []MockCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]MockCall(nil), r.calls...)
+}
+
+// Reset forgets the calls of the operations of the mock
+func (r *recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
+
+func notMocked(operation string) error {
+	return fmt.Errorf("%w: %s", ErrNotMocked, operation)
+}
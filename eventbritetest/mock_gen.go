@@ -0,0 +1,990 @@
+// Code generated by internal/servicegen. DO NOT EDIT.
+
+package eventbritetest
+
+import (
+	"golang.org/x/net/context"
+
+	"github.com/apzuk/go-eventbrite"
+)
+
+// Mock implements eventbrite.Services with the functions of its fields, one per operation,
+// e.g. EventGetFunc for EventGet. The operations without a function fail with ErrNotMocked.
+// The calls are recorded, see Calls.
+type Mock struct {
+	recorder
+
+	// CategoryService
+	CategoriesFunc    func(ctx context.Context) (*eventbrite.CategoriesResult, error)
+	CategoryFunc      func(ctx context.Context, id string) (*eventbrite.Category, error)
+	SubCategoriesFunc func(ctx context.Context) (*eventbrite.SubCategoriesResult, error)
+	SubCategoryFunc   func(ctx context.Context, id string) (*eventbrite.SubCategory, error)
+
+	// FormatService
+	FormatsFunc func(ctx context.Context) (*eventbrite.FormatResult, error)
+	FormatFunc  func(ctx context.Context, id string) (*eventbrite.Format, error)
+
+	// SystemService
+	TimezonesFunc func(ctx context.Context) (*eventbrite.Timezones, error)
+	RegionsFunc   func(ctx context.Context) (*eventbrite.Regions, error)
+	CountriesFunc func(ctx context.Context) (*eventbrite.Countries, error)
+
+	// EventService
+	EventSearchFunc                func(ctx context.Context, req *eventbrite.EventSearchRequest, expand ...eventbrite.Expansion) (*eventbrite.EventSearchResult, error)
+	EventGetFunc                   func(ctx context.Context, id string, expand ...eventbrite.Expansion) (*eventbrite.Event, error)
+	EventCreateFunc                func(ctx context.Context, req *eventbrite.EventCreateRequest) (*eventbrite.Event, error)
+	EventUpdateFunc                func(ctx context.Context, id string, req *eventbrite.EventUpdateRequest) (*eventbrite.Event, error)
+	EventPublishFunc               func(ctx context.Context, id string) (*eventbrite.PublishResult, error)
+	EventUnPublishFunc             func(ctx context.Context, id string) (*eventbrite.UnpublishResult, error)
+	EventCancelFunc                func(ctx context.Context, id string) (*eventbrite.CancelResult, error)
+	EventDeleteFunc                func(ctx context.Context, id string) (*eventbrite.DeleteResult, error)
+	EventGetDisplaySettingsFunc    func(ctx context.Context, id string) (*eventbrite.EventSettings, error)
+	EventUpdateDisplaySettingsFunc func(ctx context.Context, id string, settings *eventbrite.EventUpdateDisplaySettings) (*eventbrite.EventSettings, error)
+	EventGetTicketClassesFunc      func(ctx context.Context, id string, class *eventbrite.EventGetTicketClass) (*eventbrite.EventGetTicketClassResult, error)
+	EventCreateTicketClassFunc     func(ctx context.Context, id string, class *eventbrite.EventCreateTicketClass) (*eventbrite.TicketClass, error)
+	EventGetTicketClassFunc        func(ctx context.Context, eventId string, ticketId string) (*eventbrite.TicketClass, error)
+	EventUpdateTicketClassFunc     func(ctx context.Context, eventId string, ticketId string, class *eventbrite.EventUpdateTicketClass) (*eventbrite.TicketClass, error)
+	EventDeleteTicketClassFunc     func(ctx context.Context, eventId string, ticketId string, class *eventbrite.EventDeleteTicketClass) (*eventbrite.DeleteResult, error)
+	EventGetCannedQuestionsFunc    func(ctx context.Context, id string, q *eventbrite.EventGetCannedQuestions) (*eventbrite.QuestionsResult, error)
+	EventCreateCannedQuestionFunc  func(ctx context.Context, id string, q *eventbrite.EventCreateCannedQuestion) (*eventbrite.Question, error)
+	EventGetQuestionsFunc          func(ctx context.Context, id string, q *eventbrite.EventGetQuestions) (*eventbrite.QuestionsResult, error)
+	EventCreateQuestionFunc        func(ctx context.Context, id string, q *eventbrite.EventCreateQuestion) (*eventbrite.Question, error)
+	EventGetQuestionFunc           func(ctx context.Context, eventId string, questionId string) (*eventbrite.Question, error)
+
+	// SeriesService
+	EventSeriesCreateFunc    func(ctx context.Context, req *eventbrite.SeriesCreateEventRequest) (*eventbrite.Series, error)
+	EventSeriesGetFunc       func(ctx context.Context, id string) (*eventbrite.Series, error)
+	EventSeriesEventsFunc    func(ctx context.Context, id string, req *eventbrite.SeriesEventRequest) (*eventbrite.SeriesEventsResult, error)
+	EventSeriesPublishFunc   func(ctx context.Context, id string) (*eventbrite.PublishResult, error)
+	EventSeriesUnPublishFunc func(ctx context.Context, id string) (*eventbrite.UnpublishResult, error)
+	EventSeriesCancelFunc    func(ctx context.Context, id string) (*eventbrite.CancelResult, error)
+	EventSeriesDeleteFunc    func(ctx context.Context, id string) (*eventbrite.DeleteResult, error)
+	EventSeriesCUDFunc       func(ctx context.Context, id string, req *eventbrite.SeriesCUREventRequest) (*eventbrite.SeriesEventsResult, error)
+
+	// OrderService
+	OrderGetFunc func(ctx context.Context, id string, expand ...eventbrite.Expansion) (*eventbrite.Order, error)
+
+	// VenueService
+	VenueGetFunc    func(ctx context.Context, id string) (*eventbrite.Venue, error)
+	VenueUpdateFunc func(ctx context.Context, id string, req *eventbrite.UpdateVenueRequest) (*eventbrite.Venue, error)
+	VenueCreateFunc func(ctx context.Context, req *eventbrite.CreateVenueRequest) (*eventbrite.Venue, error)
+	VenueEventsFunc func(ctx context.Context, venueId string, expand ...eventbrite.Expansion) (*eventbrite.VenueEventsResult, error)
+
+	// OrganizerService
+	OrganizerCreateFunc    func(ctx context.Context, req *eventbrite.CreateOrganizerRequest) (*eventbrite.Organizer, error)
+	OrganizerGetFunc       func(ctx context.Context, id string) (*eventbrite.Organizer, error)
+	OrganizerUpdateFunc    func(ctx context.Context, id string, req *eventbrite.UpdateOrganizerRequest) (*eventbrite.Organizer, error)
+	OrganizerGetEventsFunc func(ctx context.Context, id string, req *eventbrite.OrganizerEventsRequest, expand ...eventbrite.Expansion) (*eventbrite.OrganizerEventsResult, error)
+
+	// UserService
+	UserFunc                          func(ctx context.Context, id string) (*eventbrite.User, error)
+	UserOrdersFunc                    func(ctx context.Context, id string, req *eventbrite.UserEventOrders, expand ...eventbrite.Expansion) (*eventbrite.UserOrdersResult, error)
+	UserOrganizersFunc                func(ctx context.Context, id string, req *eventbrite.UserOrganizerRequest) (*eventbrite.UserOrganizerResponse, error)
+	UserOwnedEventsFunc               func(ctx context.Context, id string, req *eventbrite.UserOwnedEventsRequest, expand ...eventbrite.Expansion) (*eventbrite.UserOwnedEventResponse, error)
+	UserEventsFunc                    func(ctx context.Context, id string, req eventbrite.UserEventsRequest) (*eventbrite.UserEventsResponse, error)
+	UserVenuesFunc                    func(ctx context.Context, id string) (*eventbrite.UserVenuesResponse, error)
+	UserEventAttendeesFunc            func(ctx context.Context, id string, request *eventbrite.UserEventAttendeesRequest, expand ...eventbrite.Expansion) (*eventbrite.UserEventAttendeesResponse, error)
+	UserEventOrdersFunc               func(ctx context.Context, id string, request *eventbrite.UserEventOrdersRequest, expand ...eventbrite.Expansion) (*eventbrite.UserEventOrdersResponse, error)
+	UserContactListsFunc              func(ctx context.Context, id string) (*eventbrite.UserContactListsResponse, error)
+	UserCreateContactListFunc         func(ctx context.Context, id string, request *eventbrite.UserCreateContactListsRequest) (*eventbrite.UserContactListsResponse, error)
+	UserContactListFunc               func(ctx context.Context, id string, contactListID string, request *eventbrite.UserCreateContactListsRequest) (*eventbrite.UserContactListsResponse, error)
+	UserUpdateContactListFunc         func(ctx context.Context, id string, contactListID string, request *eventbrite.UserUpdateContactListRequest) (*eventbrite.UserContactListsResponse, error)
+	UserDeleteContactListFunc         func(ctx context.Context, id string, contactListID string) (*eventbrite.DeleteResult, error)
+	UserListContactContactsFunc       func(ctx context.Context, id string, contactListID string) (*eventbrite.UserContactListContacts, error)
+	UserListContactAddContactsFunc    func(ctx context.Context, id string, contactListID string, req *eventbrite.UserAddContactListContactRequest) (*eventbrite.UserContactListContacts, error)
+	UserListContactDeleteContactsFunc func(ctx context.Context, id string, contactListID string) (*eventbrite.DeleteResult, error)
+	UserBookmarksFunc                 func(ctx context.Context, id string, req *eventbrite.UserBookmarksRequest, expand ...eventbrite.Expansion) (*eventbrite.UserBookmarksResponse, error)
+	UserSaveBookmarksFunc             func(ctx context.Context, id string, req *eventbrite.UserSaveBookmarkRequest) (*eventbrite.CreateResult, error)
+	UserUnSaveBookmarksFunc           func(ctx context.Context, id string, req *eventbrite.UserUnSaveBookmarkRequest) (*eventbrite.DeleteResult, error)
+	UserAssortmentsFunc               func(ctx context.Context, id string) (*eventbrite.Assortment, error)
+	UserSetAssortmentsFunc            func(ctx context.Context, id string, req *eventbrite.UserSetAssortmentRequest) (*eventbrite.Assortment, error)
+	UserTicketGroupsFunc              func(ctx context.Context, id string, req *eventbrite.UserTicketGroupsRequest) (*eventbrite.UserTicketGroupResponse, error)
+
+	// CheckoutService
+	CheckoutGetListFunc                 func(ctx context.Context) (*eventbrite.Checkout, error)
+	CheckoutMethodsFunc                 func(ctx context.Context, req eventbrite.CheckoutMethodsRequest) (*eventbrite.CheckoutMethodsResponse, error)
+	CheckoutForAccountFunc              func(ctx context.Context, req *eventbrite.CheckoutForAccountRequest) (*eventbrite.CheckoutSettingsForAccount, error)
+	CheckoutCreateFunc                  func(ctx context.Context, req *eventbrite.CheckoutCreateRequest) (*eventbrite.Checkout, error)
+	CheckoutGetFunc                     func(ctx context.Context, id string) (*eventbrite.Checkout, error)
+	CheckoutByEventFunc                 func(ctx context.Context, eventId string) (*eventbrite.EventCheckoutSettings, error)
+	CheckoutAssociateFunc               func(ctx context.Context, eventID string, req *eventbrite.CheckoutAssociateToEventRequest) (*eventbrite.EventCheckoutSettings, error)
+	CheckoutAssociatePayoutSettingsFunc func(ctx context.Context, eventID string, req *eventbrite.CheckoutAssociatePayoutToEvent) (*eventbrite.PayoutSettings, error)
+
+	// DiscountService
+	DiscountsGetFunc   func(ctx context.Context, id string) (*eventbrite.CrossEventDiscount, error)
+	DiscountCreateFunc func(ctx context.Context, req *eventbrite.DiscountCreateRequest) (*eventbrite.CrossEventDiscount, error)
+	DiscountUpdateFunc func(ctx context.Context, id string, req *eventbrite.DiscountUpdateRequest) (*eventbrite.CrossEventDiscount, error)
+	DiscountDeleteFunc func(ctx context.Context, id string) (*eventbrite.DeleteResult, error)
+
+	// TicketGroupService
+	TicketGroupGetFunc    func(ctx context.Context, id string) (*eventbrite.TicketGroup, error)
+	TicketGroupDeleteFunc func(ctx context.Context, id string) (*eventbrite.DeleteResult, error)
+	TicketGroupCreateFunc func(ctx context.Context, id string, req *eventbrite.CreateTicketGroupRequest) (*eventbrite.TicketGroup, error)
+	TicketGroupUpdateFunc func(ctx context.Context, id string, req *eventbrite.UpdateTicketGroupRequest) (*eventbrite.TicketGroup, error)
+
+	// MediaService
+	MediaGetFunc       func(ctx context.Context, req *eventbrite.MediaGetUpload) (*eventbrite.Media, error)
+	MediaGetUploadFunc func(ctx context.Context, id string) (*eventbrite.Image, error)
+	MediaCreateFunc    func(ctx context.Context, req *eventbrite.MediaCreateUpload) (*eventbrite.Image, error)
+
+	// NotificationService
+	NotificationsFunc func(ctx context.Context) (*eventbrite.NotificationsResult, error)
+
+	// PricingService
+	FeeRateFunc func(ctx context.Context, req *eventbrite.FeeRequest) (*eventbrite.FeeResponse, error)
+
+	// RefundRequestService
+	RefundRequestFunc       func(ctx context.Context, id string) (*eventbrite.RefundRequest, error)
+	RefundRequestUpdateFunc func(ctx context.Context, id string, req *eventbrite.UpdateOrganizerRequest) (*eventbrite.RefundRequest, error)
+	RefundRequestCreateFunc func(ctx context.Context, req *eventbrite.CreateRefundRequest) (*eventbrite.RefundRequest, error)
+
+	// ReportService
+	ReportSalesFunc     func(ctx context.Context, req *eventbrite.ReportRequest) (*eventbrite.Report, error)
+	ReportAttendeesFunc func(ctx context.Context, req *eventbrite.ReportAttendees) (*eventbrite.Report, error)
+
+	// TrackingBeaconService
+	TrackingBeaconCreateFunc      func(ctx context.Context, req *eventbrite.CreateTrackingBeaconRequest) (*eventbrite.TrackingBeacon, error)
+	TrackingBeaconGetFunc         func(ctx context.Context, id string, req *eventbrite.GetTrackingBeaconRequest) (*eventbrite.TrackingBeacon, error)
+	TrackingBeaconUpdateFunc      func(ctx context.Context, id string, req *eventbrite.UpdateTrackingBeaconRequest) (*eventbrite.TrackingBeacon, error)
+	TrackingBeaconDeleteFunc      func(ctx context.Context, id string) (*eventbrite.TrackingBeacon, error)
+	TrackingBeaconGetForEventFunc func(ctx context.Context, eventId string, req *eventbrite.GetTrackingBeaconForEventRequest) (*eventbrite.TrackingBeacon, error)
+	TrackingBeaconGetForUserFunc  func(ctx context.Context, userId string, req *eventbrite.GetTrackingBeaconForUserRequest) (*eventbrite.TrackingBeacon, error)
+
+	// WebhookService
+	WebhookGetFunc    func(ctx context.Context, id string) (*eventbrite.Webhook, error)
+	WebhookDeleteFunc func(ctx context.Context, id string) (*eventbrite.Webhook, error)
+	WebhooksFunc      func(ctx context.Context, req *eventbrite.WebhooksRequest) (*eventbrite.WebhooksResult, error)
+	WebhookCreateFunc func(ctx context.Context, req *eventbrite.CreateWebhookRequest) (*eventbrite.Webhook, error)
+}
+
+func (m *Mock) Categories(ctx context.Context) (*eventbrite.CategoriesResult, error) {
+	m.record("Categories", []interface{}{})
+	if m.CategoriesFunc == nil {
+		return nil, notMocked("Categories")
+	}
+	return m.CategoriesFunc(ctx)
+}
+
+func (m *Mock) Category(ctx context.Context, id string) (*eventbrite.Category, error) {
+	m.record("Category", []interface{}{id})
+	if m.CategoryFunc == nil {
+		return nil, notMocked("Category")
+	}
+	return m.CategoryFunc(ctx, id)
+}
+
+func (m *Mock) SubCategories(ctx context.Context) (*eventbrite.SubCategoriesResult, error) {
+	m.record("SubCategories", []interface{}{})
+	if m.SubCategoriesFunc == nil {
+		return nil, notMocked("SubCategories")
+	}
+	return m.SubCategoriesFunc(ctx)
+}
+
+func (m *Mock) SubCategory(ctx context.Context, id string) (*eventbrite.SubCategory, error) {
+	m.record("SubCategory", []interface{}{id})
+	if m.SubCategoryFunc == nil {
+		return nil, notMocked("SubCategory")
+	}
+	return m.SubCategoryFunc(ctx, id)
+}
+
+func (m *Mock) Formats(ctx context.Context) (*eventbrite.FormatResult, error) {
+	m.record("Formats", []interface{}{})
+	if m.FormatsFunc == nil {
+		return nil, notMocked("Formats")
+	}
+	return m.FormatsFunc(ctx)
+}
+
+func (m *Mock) Format(ctx context.Context, id string) (*eventbrite.Format, error) {
+	m.record("Format", []interface{}{id})
+	if m.FormatFunc == nil {
+		return nil, notMocked("Format")
+	}
+	return m.FormatFunc(ctx, id)
+}
+
+func (m *Mock) Timezones(ctx context.Context) (*eventbrite.Timezones, error) {
+	m.record("Timezones", []interface{}{})
+	if m.TimezonesFunc == nil {
+		return nil, notMocked("Timezones")
+	}
+	return m.TimezonesFunc(ctx)
+}
+
+func (m *Mock) Regions(ctx context.Context) (*eventbrite.Regions, error) {
+	m.record("Regions", []interface{}{})
+	if m.RegionsFunc == nil {
+		return nil, notMocked("Regions")
+	}
+	return m.RegionsFunc(ctx)
+}
+
+func (m *Mock) Countries(ctx context.Context) (*eventbrite.Countries, error) {
+	m.record("Countries", []interface{}{})
+	if m.CountriesFunc == nil {
+		return nil, notMocked("Countries")
+	}
+	return m.CountriesFunc(ctx)
+}
+
+func (m *Mock) EventSearch(ctx context.Context, req *eventbrite.EventSearchRequest, expand ...eventbrite.Expansion) (*eventbrite.EventSearchResult, error) {
+	m.record("EventSearch", []interface{}{req, expand})
+	if m.EventSearchFunc == nil {
+		return nil, notMocked("EventSearch")
+	}
+	return m.EventSearchFunc(ctx, req, expand...)
+}
+
+func (m *Mock) EventGet(ctx context.Context, id string, expand ...eventbrite.Expansion) (*eventbrite.Event, error) {
+	m.record("EventGet", []interface{}{id, expand})
+	if m.EventGetFunc == nil {
+		return nil, notMocked("EventGet")
+	}
+	return m.EventGetFunc(ctx, id, expand...)
+}
+
+func (m *Mock) EventCreate(ctx context.Context, req *eventbrite.EventCreateRequest) (*eventbrite.Event, error) {
+	m.record("EventCreate", []interface{}{req})
+	if m.EventCreateFunc == nil {
+		return nil, notMocked("EventCreate")
+	}
+	return m.EventCreateFunc(ctx, req)
+}
+
+func (m *Mock) EventUpdate(ctx context.Context, id string, req *eventbrite.EventUpdateRequest) (*eventbrite.Event, error) {
+	m.record("EventUpdate", []interface{}{id, req})
+	if m.EventUpdateFunc == nil {
+		return nil, notMocked("EventUpdate")
+	}
+	return m.EventUpdateFunc(ctx, id, req)
+}
+
+func (m *Mock) EventPublish(ctx context.Context, id string) (*eventbrite.PublishResult, error) {
+	m.record("EventPublish", []interface{}{id})
+	if m.EventPublishFunc == nil {
+		return nil, notMocked("EventPublish")
+	}
+	return m.EventPublishFunc(ctx, id)
+}
+
+func (m *Mock) EventUnPublish(ctx context.Context, id string) (*eventbrite.UnpublishResult, error) {
+	m.record("EventUnPublish", []interface{}{id})
+	if m.EventUnPublishFunc == nil {
+		return nil, notMocked("EventUnPublish")
+	}
+	return m.EventUnPublishFunc(ctx, id)
+}
+
+func (m *Mock) EventCancel(ctx context.Context, id string) (*eventbrite.CancelResult, error) {
+	m.record("EventCancel", []interface{}{id})
+	if m.EventCancelFunc == nil {
+		return nil, notMocked("EventCancel")
+	}
+	return m.EventCancelFunc(ctx, id)
+}
+
+func (m *Mock) EventDelete(ctx context.Context, id string) (*eventbrite.DeleteResult, error) {
+	m.record("EventDelete", []interface{}{id})
+	if m.EventDeleteFunc == nil {
+		return nil, notMocked("EventDelete")
+	}
+	return m.EventDeleteFunc(ctx, id)
+}
+
+func (m *Mock) EventGetDisplaySettings(ctx context.Context, id string) (*eventbrite.EventSettings, error) {
+	m.record("EventGetDisplaySettings", []interface{}{id})
+	if m.EventGetDisplaySettingsFunc == nil {
+		return nil, notMocked("EventGetDisplaySettings")
+	}
+	return m.EventGetDisplaySettingsFunc(ctx, id)
+}
+
+func (m *Mock) EventUpdateDisplaySettings(ctx context.Context, id string, settings *eventbrite.EventUpdateDisplaySettings) (*eventbrite.EventSettings, error) {
+	m.record("EventUpdateDisplaySettings", []interface{}{id, settings})
+	if m.EventUpdateDisplaySettingsFunc == nil {
+		return nil, notMocked("EventUpdateDisplaySettings")
+	}
+	return m.EventUpdateDisplaySettingsFunc(ctx, id, settings)
+}
+
+func (m *Mock) EventGetTicketClasses(ctx context.Context, id string, class *eventbrite.EventGetTicketClass) (*eventbrite.EventGetTicketClassResult, error) {
+	m.record("EventGetTicketClasses", []interface{}{id, class})
+	if m.EventGetTicketClassesFunc == nil {
+		return nil, notMocked("EventGetTicketClasses")
+	}
+	return m.EventGetTicketClassesFunc(ctx, id, class)
+}
+
+func (m *Mock) EventCreateTicketClass(ctx context.Context, id string, class *eventbrite.EventCreateTicketClass) (*eventbrite.TicketClass, error) {
+	m.record("EventCreateTicketClass", []interface{}{id, class})
+	if m.EventCreateTicketClassFunc == nil {
+		return nil, notMocked("EventCreateTicketClass")
+	}
+	return m.EventCreateTicketClassFunc(ctx, id, class)
+}
+
+func (m *Mock) EventGetTicketClass(ctx context.Context, eventId string, ticketId string) (*eventbrite.TicketClass, error) {
+	m.record("EventGetTicketClass", []interface{}{eventId, ticketId})
+	if m.EventGetTicketClassFunc == nil {
+		return nil, notMocked("EventGetTicketClass")
+	}
+	return m.EventGetTicketClassFunc(ctx, eventId, ticketId)
+}
+
+func (m *Mock) EventUpdateTicketClass(ctx context.Context, eventId string, ticketId string, class *eventbrite.EventUpdateTicketClass) (*eventbrite.TicketClass, error) {
+	m.record("EventUpdateTicketClass", []interface{}{eventId, ticketId, class})
+	if m.EventUpdateTicketClassFunc == nil {
+		return nil, notMocked("EventUpdateTicketClass")
+	}
+	return m.EventUpdateTicketClassFunc(ctx, eventId, ticketId, class)
+}
+
+func (m *Mock) EventDeleteTicketClass(ctx context.Context, eventId string, ticketId string, class *eventbrite.EventDeleteTicketClass) (*eventbrite.DeleteResult, error) {
+	m.record("EventDeleteTicketClass", []interface{}{eventId, ticketId, class})
+	if m.EventDeleteTicketClassFunc == nil {
+		return nil, notMocked("EventDeleteTicketClass")
+	}
+	return m.EventDeleteTicketClassFunc(ctx, eventId, ticketId, class)
+}
+
+func (m *Mock) EventGetCannedQuestions(ctx context.Context, id string, q *eventbrite.EventGetCannedQuestions) (*eventbrite.QuestionsResult, error) {
+	m.record("EventGetCannedQuestions", []interface{}{id, q})
+	if m.EventGetCannedQuestionsFunc == nil {
+		return nil, notMocked("EventGetCannedQuestions")
+	}
+	return m.EventGetCannedQuestionsFunc(ctx, id, q)
+}
+
+func (m *Mock) EventCreateCannedQuestion(ctx context.Context, id string, q *eventbrite.EventCreateCannedQuestion) (*eventbrite.Question, error) {
+	m.record("EventCreateCannedQuestion", []interface{}{id, q})
+	if m.EventCreateCannedQuestionFunc == nil {
+		return nil, notMocked("EventCreateCannedQuestion")
+	}
+	return m.EventCreateCannedQuestionFunc(ctx, id, q)
+}
+
+func (m *Mock) EventGetQuestions(ctx context.Context, id string, q *eventbrite.EventGetQuestions) (*eventbrite.QuestionsResult, error) {
+	m.record("EventGetQuestions", []interface{}{id, q})
+	if m.EventGetQuestionsFunc == nil {
+		return nil, notMocked("EventGetQuestions")
+	}
+	return m.EventGetQuestionsFunc(ctx, id, q)
+}
+
+func (m *Mock) EventCreateQuestion(ctx context.Context, id string, q *eventbrite.EventCreateQuestion) (*eventbrite.Question, error) {
+	m.record("EventCreateQuestion", []interface{}{id, q})
+	if m.EventCreateQuestionFunc == nil {
+		return nil, notMocked("EventCreateQuestion")
+	}
+	return m.EventCreateQuestionFunc(ctx, id, q)
+}
+
+func (m *Mock) EventGetQuestion(ctx context.Context, eventId string, questionId string) (*eventbrite.Question, error) {
+	m.record("EventGetQuestion", []interface{}{eventId, questionId})
+	if m.EventGetQuestionFunc == nil {
+		return nil, notMocked("EventGetQuestion")
+	}
+	return m.EventGetQuestionFunc(ctx, eventId, questionId)
+}
+
+func (m *Mock) EventSeriesCreate(ctx context.Context, req *eventbrite.SeriesCreateEventRequest) (*eventbrite.Series, error) {
+	m.record("EventSeriesCreate", []interface{}{req})
+	if m.EventSeriesCreateFunc == nil {
+		return nil, notMocked("EventSeriesCreate")
+	}
+	return m.EventSeriesCreateFunc(ctx, req)
+}
+
+func (m *Mock) EventSeriesGet(ctx context.Context, id string) (*eventbrite.Series, error) {
+	m.record("EventSeriesGet", []interface{}{id})
+	if m.EventSeriesGetFunc == nil {
+		return nil, notMocked("EventSeriesGet")
+	}
+	return m.EventSeriesGetFunc(ctx, id)
+}
+
+func (m *Mock) EventSeriesEvents(ctx context.Context, id string, req *eventbrite.SeriesEventRequest) (*eventbrite.SeriesEventsResult, error) {
+	m.record("EventSeriesEvents", []interface{}{id, req})
+	if m.EventSeriesEventsFunc == nil {
+		return nil, notMocked("EventSeriesEvents")
+	}
+	return m.EventSeriesEventsFunc(ctx, id, req)
+}
+
+func (m *Mock) EventSeriesPublish(ctx context.Context, id string) (*eventbrite.PublishResult, error) {
+	m.record("EventSeriesPublish", []interface{}{id})
+	if m.EventSeriesPublishFunc == nil {
+		return nil, notMocked("EventSeriesPublish")
+	}
+	return m.EventSeriesPublishFunc(ctx, id)
+}
+
+func (m *Mock) EventSeriesUnPublish(ctx context.Context, id string) (*eventbrite.UnpublishResult, error) {
+	m.record("EventSeriesUnPublish", []interface{}{id})
+	if m.EventSeriesUnPublishFunc == nil {
+		return nil, notMocked("EventSeriesUnPublish")
+	}
+	return m.EventSeriesUnPublishFunc(ctx, id)
+}
+
+func (m *Mock) EventSeriesCancel(ctx context.Context, id string) (*eventbrite.CancelResult, error) {
+	m.record("EventSeriesCancel", []interface{}{id})
+	if m.EventSeriesCancelFunc == nil {
+		return nil, notMocked("EventSeriesCancel")
+	}
+	return m.EventSeriesCancelFunc(ctx, id)
+}
+
+func (m *Mock) EventSeriesDelete(ctx context.Context, id string) (*eventbrite.DeleteResult, error) {
+	m.record("EventSeriesDelete", []interface{}{id})
+	if m.EventSeriesDeleteFunc == nil {
+		return nil, notMocked("EventSeriesDelete")
+	}
+	return m.EventSeriesDeleteFunc(ctx, id)
+}
+
+func (m *Mock) EventSeriesCUD(ctx context.Context, id string, req *eventbrite.SeriesCUREventRequest) (*eventbrite.SeriesEventsResult, error) {
+	m.record("EventSeriesCUD", []interface{}{id, req})
+	if m.EventSeriesCUDFunc == nil {
+		return nil, notMocked("EventSeriesCUD")
+	}
+	return m.EventSeriesCUDFunc(ctx, id, req)
+}
+
+func (m *Mock) OrderGet(ctx context.Context, id string, expand ...eventbrite.Expansion) (*eventbrite.Order, error) {
+	m.record("OrderGet", []interface{}{id, expand})
+	if m.OrderGetFunc == nil {
+		return nil, notMocked("OrderGet")
+	}
+	return m.OrderGetFunc(ctx, id, expand...)
+}
+
+func (m *Mock) VenueGet(ctx context.Context, id string) (*eventbrite.Venue, error) {
+	m.record("VenueGet", []interface{}{id})
+	if m.VenueGetFunc == nil {
+		return nil, notMocked("VenueGet")
+	}
+	return m.VenueGetFunc(ctx, id)
+}
+
+func (m *Mock) VenueUpdate(ctx context.Context, id string, req *eventbrite.UpdateVenueRequest) (*eventbrite.Venue, error) {
+	m.record("VenueUpdate", []interface{}{id, req})
+	if m.VenueUpdateFunc == nil {
+		return nil, notMocked("VenueUpdate")
+	}
+	return m.VenueUpdateFunc(ctx, id, req)
+}
+
+func (m *Mock) VenueCreate(ctx context.Context, req *eventbrite.CreateVenueRequest) (*eventbrite.Venue, error) {
+	m.record("VenueCreate", []interface{}{req})
+	if m.VenueCreateFunc == nil {
+		return nil, notMocked("VenueCreate")
+	}
+	return m.VenueCreateFunc(ctx, req)
+}
+
+func (m *Mock) VenueEvents(ctx context.Context, venueId string, expand ...eventbrite.Expansion) (*eventbrite.VenueEventsResult, error) {
+	m.record("VenueEvents", []interface{}{venueId, expand})
+	if m.VenueEventsFunc == nil {
+		return nil, notMocked("VenueEvents")
+	}
+	return m.VenueEventsFunc(ctx, venueId, expand...)
+}
+
+func (m *Mock) OrganizerCreate(ctx context.Context, req *eventbrite.CreateOrganizerRequest) (*eventbrite.Organizer, error) {
+	m.record("OrganizerCreate", []interface{}{req})
+	if m.OrganizerCreateFunc == nil {
+		return nil, notMocked("OrganizerCreate")
+	}
+	return m.OrganizerCreateFunc(ctx, req)
+}
+
+func (m *Mock) OrganizerGet(ctx context.Context, id string) (*eventbrite.Organizer, error) {
+	m.record("OrganizerGet", []interface{}{id})
+	if m.OrganizerGetFunc == nil {
+		return nil, notMocked("OrganizerGet")
+	}
+	return m.OrganizerGetFunc(ctx, id)
+}
+
+func (m *Mock) OrganizerUpdate(ctx context.Context, id string, req *eventbrite.UpdateOrganizerRequest) (*eventbrite.Organizer, error) {
+	m.record("OrganizerUpdate", []interface{}{id, req})
+	if m.OrganizerUpdateFunc == nil {
+		return nil, notMocked("OrganizerUpdate")
+	}
+	return m.OrganizerUpdateFunc(ctx, id, req)
+}
+
+func (m *Mock) OrganizerGetEvents(ctx context.Context, id string, req *eventbrite.OrganizerEventsRequest, expand ...eventbrite.Expansion) (*eventbrite.OrganizerEventsResult, error) {
+	m.record("OrganizerGetEvents", []interface{}{id, req, expand})
+	if m.OrganizerGetEventsFunc == nil {
+		return nil, notMocked("OrganizerGetEvents")
+	}
+	return m.OrganizerGetEventsFunc(ctx, id, req, expand...)
+}
+
+func (m *Mock) User(ctx context.Context, id string) (*eventbrite.User, error) {
+	m.record("User", []interface{}{id})
+	if m.UserFunc == nil {
+		return nil, notMocked("User")
+	}
+	return m.UserFunc(ctx, id)
+}
+
+func (m *Mock) UserOrders(ctx context.Context, id string, req *eventbrite.UserEventOrders, expand ...eventbrite.Expansion) (*eventbrite.UserOrdersResult, error) {
+	m.record("UserOrders", []interface{}{id, req, expand})
+	if m.UserOrdersFunc == nil {
+		return nil, notMocked("UserOrders")
+	}
+	return m.UserOrdersFunc(ctx, id, req, expand...)
+}
+
+func (m *Mock) UserOrganizers(ctx context.Context, id string, req *eventbrite.UserOrganizerRequest) (*eventbrite.UserOrganizerResponse, error) {
+	m.record("UserOrganizers", []interface{}{id, req})
+	if m.UserOrganizersFunc == nil {
+		return nil, notMocked("UserOrganizers")
+	}
+	return m.UserOrganizersFunc(ctx, id, req)
+}
+
+func (m *Mock) UserOwnedEvents(ctx context.Context, id string, req *eventbrite.UserOwnedEventsRequest, expand ...eventbrite.Expansion) (*eventbrite.UserOwnedEventResponse, error) {
+	m.record("UserOwnedEvents", []interface{}{id, req, expand})
+	if m.UserOwnedEventsFunc == nil {
+		return nil, notMocked("UserOwnedEvents")
+	}
+	return m.UserOwnedEventsFunc(ctx, id, req, expand...)
+}
+
+func (m *Mock) UserEvents(ctx context.Context, id string, req eventbrite.UserEventsRequest) (*eventbrite.UserEventsResponse, error) {
+	m.record("UserEvents", []interface{}{id, req})
+	if m.UserEventsFunc == nil {
+		return nil, notMocked("UserEvents")
+	}
+	return m.UserEventsFunc(ctx, id, req)
+}
+
+func (m *Mock) UserVenues(ctx context.Context, id string) (*eventbrite.UserVenuesResponse, error) {
+	m.record("UserVenues", []interface{}{id})
+	if m.UserVenuesFunc == nil {
+		return nil, notMocked("UserVenues")
+	}
+	return m.UserVenuesFunc(ctx, id)
+}
+
+func (m *Mock) UserEventAttendees(ctx context.Context, id string, request *eventbrite.UserEventAttendeesRequest, expand ...eventbrite.Expansion) (*eventbrite.UserEventAttendeesResponse, error) {
+	m.record("UserEventAttendees", []interface{}{id, request, expand})
+	if m.UserEventAttendeesFunc == nil {
+		return nil, notMocked("UserEventAttendees")
+	}
+	return m.UserEventAttendeesFunc(ctx, id, request, expand...)
+}
+
+func (m *Mock) UserEventOrders(ctx context.Context, id string, request *eventbrite.UserEventOrdersRequest, expand ...eventbrite.Expansion) (*eventbrite.UserEventOrdersResponse, error) {
+	m.record("UserEventOrders", []interface{}{id, request, expand})
+	if m.UserEventOrdersFunc == nil {
+		return nil, notMocked("UserEventOrders")
+	}
+	return m.UserEventOrdersFunc(ctx, id, request, expand...)
+}
+
+func (m *Mock) UserContactLists(ctx context.Context, id string) (*eventbrite.UserContactListsResponse, error) {
+	m.record("UserContactLists", []interface{}{id})
+	if m.UserContactListsFunc == nil {
+		return nil, notMocked("UserContactLists")
+	}
+	return m.UserContactListsFunc(ctx, id)
+}
+
+func (m *Mock) UserCreateContactList(ctx context.Context, id string, request *eventbrite.UserCreateContactListsRequest) (*eventbrite.UserContactListsResponse, error) {
+	m.record("UserCreateContactList", []interface{}{id, request})
+	if m.UserCreateContactListFunc == nil {
+		return nil, notMocked("UserCreateContactList")
+	}
+	return m.UserCreateContactListFunc(ctx, id, request)
+}
+
+func (m *Mock) UserContactList(ctx context.Context, id string, contactListID string, request *eventbrite.UserCreateContactListsRequest) (*eventbrite.UserContactListsResponse, error) {
+	m.record("UserContactList", []interface{}{id, contactListID, request})
+	if m.UserContactListFunc == nil {
+		return nil, notMocked("UserContactList")
+	}
+	return m.UserContactListFunc(ctx, id, contactListID, request)
+}
+
+func (m *Mock) UserUpdateContactList(ctx context.Context, id string, contactListID string, request *eventbrite.UserUpdateContactListRequest) (*eventbrite.UserContactListsResponse, error) {
+	m.record("UserUpdateContactList", []interface{}{id, contactListID, request})
+	if m.UserUpdateContactListFunc == nil {
+		return nil, notMocked("UserUpdateContactList")
+	}
+	return m.UserUpdateContactListFunc(ctx, id, contactListID, request)
+}
+
+func (m *Mock) UserDeleteContactList(ctx context.Context, id string, contactListID string) (*eventbrite.DeleteResult, error) {
+	m.record("UserDeleteContactList", []interface{}{id, contactListID})
+	if m.UserDeleteContactListFunc == nil {
+		return nil, notMocked("UserDeleteContactList")
+	}
+	return m.UserDeleteContactListFunc(ctx, id, contactListID)
+}
+
+func (m *Mock) UserListContactContacts(ctx context.Context, id string, contactListID string) (*eventbrite.UserContactListContacts, error) {
+	m.record("UserListContactContacts", []interface{}{id, contactListID})
+	if m.UserListContactContactsFunc == nil {
+		return nil, notMocked("UserListContactContacts")
+	}
+	return m.UserListContactContactsFunc(ctx, id, contactListID)
+}
+
+func (m *Mock) UserListContactAddContacts(ctx context.Context, id string, contactListID string, req *eventbrite.UserAddContactListContactRequest) (*eventbrite.UserContactListContacts, error) {
+	m.record("UserListContactAddContacts", []interface{}{id, contactListID, req})
+	if m.UserListContactAddContactsFunc == nil {
+		return nil, notMocked("UserListContactAddContacts")
+	}
+	return m.UserListContactAddContactsFunc(ctx, id, contactListID, req)
+}
+
+func (m *Mock) UserListContactDeleteContacts(ctx context.Context, id string, contactListID string) (*eventbrite.DeleteResult, error) {
+	m.record("UserListContactDeleteContacts", []interface{}{id, contactListID})
+	if m.UserListContactDeleteContactsFunc == nil {
+		return nil, notMocked("UserListContactDeleteContacts")
+	}
+	return m.UserListContactDeleteContactsFunc(ctx, id, contactListID)
+}
+
+func (m *Mock) UserBookmarks(ctx context.Context, id string, req *eventbrite.UserBookmarksRequest, expand ...eventbrite.Expansion) (*eventbrite.UserBookmarksResponse, error) {
+	m.record("UserBookmarks", []interface{}{id, req, expand})
+	if m.UserBookmarksFunc == nil {
+		return nil, notMocked("UserBookmarks")
+	}
+	return m.UserBookmarksFunc(ctx, id, req, expand...)
+}
+
+func (m *Mock) UserSaveBookmarks(ctx context.Context, id string, req *eventbrite.UserSaveBookmarkRequest) (*eventbrite.CreateResult, error) {
+	m.record("UserSaveBookmarks", []interface{}{id, req})
+	if m.UserSaveBookmarksFunc == nil {
+		return nil, notMocked("UserSaveBookmarks")
+	}
+	return m.UserSaveBookmarksFunc(ctx, id, req)
+}
+
+func (m *Mock) UserUnSaveBookmarks(ctx context.Context, id string, req *eventbrite.UserUnSaveBookmarkRequest) (*eventbrite.DeleteResult, error) {
+	m.record("UserUnSaveBookmarks", []interface{}{id, req})
+	if m.UserUnSaveBookmarksFunc == nil {
+		return nil, notMocked("UserUnSaveBookmarks")
+	}
+	return m.UserUnSaveBookmarksFunc(ctx, id, req)
+}
+
+func (m *Mock) UserAssortments(ctx context.Context, id string) (*eventbrite.Assortment, error) {
+	m.record("UserAssortments", []interface{}{id})
+	if m.UserAssortmentsFunc == nil {
+		return nil, notMocked("UserAssortments")
+	}
+	return m.UserAssortmentsFunc(ctx, id)
+}
+
+func (m *Mock) UserSetAssortments(ctx context.Context, id string, req *eventbrite.UserSetAssortmentRequest) (*eventbrite.Assortment, error) {
+	m.record("UserSetAssortments", []interface{}{id, req})
+	if m.UserSetAssortmentsFunc == nil {
+		return nil, notMocked("UserSetAssortments")
+	}
+	return m.UserSetAssortmentsFunc(ctx, id, req)
+}
+
+func (m *Mock) UserTicketGroups(ctx context.Context, id string, req *eventbrite.UserTicketGroupsRequest) (*eventbrite.UserTicketGroupResponse, error) {
+	m.record("UserTicketGroups", []interface{}{id, req})
+	if m.UserTicketGroupsFunc == nil {
+		return nil, notMocked("UserTicketGroups")
+	}
+	return m.UserTicketGroupsFunc(ctx, id, req)
+}
+
+func (m *Mock) CheckoutGetList(ctx context.Context) (*eventbrite.Checkout, error) {
+	m.record("CheckoutGetList", []interface{}{})
+	if m.CheckoutGetListFunc == nil {
+		return nil, notMocked("CheckoutGetList")
+	}
+	return m.CheckoutGetListFunc(ctx)
+}
+
+func (m *Mock) CheckoutMethods(ctx context.Context, req eventbrite.CheckoutMethodsRequest) (*eventbrite.CheckoutMethodsResponse, error) {
+	m.record("CheckoutMethods", []interface{}{req})
+	if m.CheckoutMethodsFunc == nil {
+		return nil, notMocked("CheckoutMethods")
+	}
+	return m.CheckoutMethodsFunc(ctx, req)
+}
+
+func (m *Mock) CheckoutForAccount(ctx context.Context, req *eventbrite.CheckoutForAccountRequest) (*eventbrite.CheckoutSettingsForAccount, error) {
+	m.record("CheckoutForAccount", []interface{}{req})
+	if m.CheckoutForAccountFunc == nil {
+		return nil, notMocked("CheckoutForAccount")
+	}
+	return m.CheckoutForAccountFunc(ctx, req)
+}
+
+func (m *Mock) CheckoutCreate(ctx context.Context, req *eventbrite.CheckoutCreateRequest) (*eventbrite.Checkout, error) {
+	m.record("CheckoutCreate", []interface{}{req})
+	if m.CheckoutCreateFunc == nil {
+		return nil, notMocked("CheckoutCreate")
+	}
+	return m.CheckoutCreateFunc(ctx, req)
+}
+
+func (m *Mock) CheckoutGet(ctx context.Context, id string) (*eventbrite.Checkout, error) {
+	m.record("CheckoutGet", []interface{}{id})
+	if m.CheckoutGetFunc == nil {
+		return nil, notMocked("CheckoutGet")
+	}
+	return m.CheckoutGetFunc(ctx, id)
+}
+
+func (m *Mock) CheckoutByEvent(ctx context.Context, eventId string) (*eventbrite.EventCheckoutSettings, error) {
+	m.record("CheckoutByEvent", []interface{}{eventId})
+	if m.CheckoutByEventFunc == nil {
+		return nil, notMocked("CheckoutByEvent")
+	}
+	return m.CheckoutByEventFunc(ctx, eventId)
+}
+
+func (m *Mock) CheckoutAssociate(ctx context.Context, eventID string, req *eventbrite.CheckoutAssociateToEventRequest) (*eventbrite.EventCheckoutSettings, error) {
+	m.record("CheckoutAssociate", []interface{}{eventID, req})
+	if m.CheckoutAssociateFunc == nil {
+		return nil, notMocked("CheckoutAssociate")
+	}
+	return m.CheckoutAssociateFunc(ctx, eventID, req)
+}
+
+func (m *Mock) CheckoutAssociatePayoutSettings(ctx context.Context, eventID string, req *eventbrite.CheckoutAssociatePayoutToEvent) (*eventbrite.PayoutSettings, error) {
+	m.record("CheckoutAssociatePayoutSettings", []interface{}{eventID, req})
+	if m.CheckoutAssociatePayoutSettingsFunc == nil {
+		return nil, notMocked("CheckoutAssociatePayoutSettings")
+	}
+	return m.CheckoutAssociatePayoutSettingsFunc(ctx, eventID, req)
+}
+
+func (m *Mock) DiscountsGet(ctx context.Context, id string) (*eventbrite.CrossEventDiscount, error) {
+	m.record("DiscountsGet", []interface{}{id})
+	if m.DiscountsGetFunc == nil {
+		return nil, notMocked("DiscountsGet")
+	}
+	return m.DiscountsGetFunc(ctx, id)
+}
+
+func (m *Mock) DiscountCreate(ctx context.Context, req *eventbrite.DiscountCreateRequest) (*eventbrite.CrossEventDiscount, error) {
+	m.record("DiscountCreate", []interface{}{req})
+	if m.DiscountCreateFunc == nil {
+		return nil, notMocked("DiscountCreate")
+	}
+	return m.DiscountCreateFunc(ctx, req)
+}
+
+func (m *Mock) DiscountUpdate(ctx context.Context, id string, req *eventbrite.DiscountUpdateRequest) (*eventbrite.CrossEventDiscount, error) {
+	m.record("DiscountUpdate", []interface{}{id, req})
+	if m.DiscountUpdateFunc == nil {
+		return nil, notMocked("DiscountUpdate")
+	}
+	return m.DiscountUpdateFunc(ctx, id, req)
+}
+
+func (m *Mock) DiscountDelete(ctx context.Context, id string) (*eventbrite.DeleteResult, error) {
+	m.record("DiscountDelete", []interface{}{id})
+	if m.DiscountDeleteFunc == nil {
+		return nil, notMocked("DiscountDelete")
+	}
+	return m.DiscountDeleteFunc(ctx, id)
+}
+
+func (m *Mock) TicketGroupGet(ctx context.Context, id string) (*eventbrite.TicketGroup, error) {
+	m.record("TicketGroupGet", []interface{}{id})
+	if m.TicketGroupGetFunc == nil {
+		return nil, notMocked("TicketGroupGet")
+	}
+	return m.TicketGroupGetFunc(ctx, id)
+}
+
+func (m *Mock) TicketGroupDelete(ctx context.Context, id string) (*eventbrite.DeleteResult, error) {
+	m.record("TicketGroupDelete", []interface{}{id})
+	if m.TicketGroupDeleteFunc == nil {
+		return nil, notMocked("TicketGroupDelete")
+	}
+	return m.TicketGroupDeleteFunc(ctx, id)
+}
+
+func (m *Mock) TicketGroupCreate(ctx context.Context, id string, req *eventbrite.CreateTicketGroupRequest) (*eventbrite.TicketGroup, error) {
+	m.record("TicketGroupCreate", []interface{}{id, req})
+	if m.TicketGroupCreateFunc == nil {
+		return nil, notMocked("TicketGroupCreate")
+	}
+	return m.TicketGroupCreateFunc(ctx, id, req)
+}
+
+func (m *Mock) TicketGroupUpdate(ctx context.Context, id string, req *eventbrite.UpdateTicketGroupRequest) (*eventbrite.TicketGroup, error) {
+	m.record("TicketGroupUpdate", []interface{}{id, req})
+	if m.TicketGroupUpdateFunc == nil {
+		return nil, notMocked("TicketGroupUpdate")
+	}
+	return m.TicketGroupUpdateFunc(ctx, id, req)
+}
+
+func (m *Mock) MediaGet(ctx context.Context, req *eventbrite.MediaGetUpload) (*eventbrite.Media, error) {
+	m.record("MediaGet", []interface{}{req})
+	if m.MediaGetFunc == nil {
+		return nil, notMocked("MediaGet")
+	}
+	return m.MediaGetFunc(ctx, req)
+}
+
+func (m *Mock) MediaGetUpload(ctx context.Context, id string) (*eventbrite.Image, error) {
+	m.record("MediaGetUpload", []interface{}{id})
+	if m.MediaGetUploadFunc == nil {
+		return nil, notMocked("MediaGetUpload")
+	}
+	return m.MediaGetUploadFunc(ctx, id)
+}
+
+func (m *Mock) MediaCreate(ctx context.Context, req *eventbrite.MediaCreateUpload) (*eventbrite.Image, error) {
+	m.record("MediaCreate", []interface{}{req})
+	if m.MediaCreateFunc == nil {
+		return nil, notMocked("MediaCreate")
+	}
+	return m.MediaCreateFunc(ctx, req)
+}
+
+func (m *Mock) Notifications(ctx context.Context) (*eventbrite.NotificationsResult, error) {
+	m.record("Notifications", []interface{}{})
+	if m.NotificationsFunc == nil {
+		return nil, notMocked("Notifications")
+	}
+	return m.NotificationsFunc(ctx)
+}
+
+func (m *Mock) FeeRate(ctx context.Context, req *eventbrite.FeeRequest) (*eventbrite.FeeResponse, error) {
+	m.record("FeeRate", []interface{}{req})
+	if m.FeeRateFunc == nil {
+		return nil, notMocked("FeeRate")
+	}
+	return m.FeeRateFunc(ctx, req)
+}
+
+func (m *Mock) RefundRequest(ctx context.Context, id string) (*eventbrite.RefundRequest, error) {
+	m.record("RefundRequest", []interface{}{id})
+	if m.RefundRequestFunc == nil {
+		return nil, notMocked("RefundRequest")
+	}
+	return m.RefundRequestFunc(ctx, id)
+}
+
+func (m *Mock) RefundRequestUpdate(ctx context.Context, id string, req *eventbrite.UpdateOrganizerRequest) (*eventbrite.RefundRequest, error) {
+	m.record("RefundRequestUpdate", []interface{}{id, req})
+	if m.RefundRequestUpdateFunc == nil {
+		return nil, notMocked("RefundRequestUpdate")
+	}
+	return m.RefundRequestUpdateFunc(ctx, id, req)
+}
+
+func (m *Mock) RefundRequestCreate(ctx context.Context, req *eventbrite.CreateRefundRequest) (*eventbrite.RefundRequest, error) {
+	m.record("RefundRequestCreate", []interface{}{req})
+	if m.RefundRequestCreateFunc == nil {
+		return nil, notMocked("RefundRequestCreate")
+	}
+	return m.RefundRequestCreateFunc(ctx, req)
+}
+
+func (m *Mock) ReportSales(ctx context.Context, req *eventbrite.ReportRequest) (*eventbrite.Report, error) {
+	m.record("ReportSales", []interface{}{req})
+	if m.ReportSalesFunc == nil {
+		return nil, notMocked("ReportSales")
+	}
+	return m.ReportSalesFunc(ctx, req)
+}
+
+func (m *Mock) ReportAttendees(ctx context.Context, req *eventbrite.ReportAttendees) (*eventbrite.Report, error) {
+	m.record("ReportAttendees", []interface{}{req})
+	if m.ReportAttendeesFunc == nil {
+		return nil, notMocked("ReportAttendees")
+	}
+	return m.ReportAttendeesFunc(ctx, req)
+}
+
+func (m *Mock) TrackingBeaconCreate(ctx context.Context, req *eventbrite.CreateTrackingBeaconRequest) (*eventbrite.TrackingBeacon, error) {
+	m.record("TrackingBeaconCreate", []interface{}{req})
+	if m.TrackingBeaconCreateFunc == nil {
+		return nil, notMocked("TrackingBeaconCreate")
+	}
+	return m.TrackingBeaconCreateFunc(ctx, req)
+}
+
+func (m *Mock) TrackingBeaconGet(ctx context.Context, id string, req *eventbrite.GetTrackingBeaconRequest) (*eventbrite.TrackingBeacon, error) {
+	m.record("TrackingBeaconGet", []interface{}{id, req})
+	if m.TrackingBeaconGetFunc == nil {
+		return nil, notMocked("TrackingBeaconGet")
+	}
+	return m.TrackingBeaconGetFunc(ctx, id, req)
+}
+
+func (m *Mock) TrackingBeaconUpdate(ctx context.Context, id string, req *eventbrite.UpdateTrackingBeaconRequest) (*eventbrite.TrackingBeacon, error) {
+	m.record("TrackingBeaconUpdate", []interface{}{id, req})
+	if m.TrackingBeaconUpdateFunc == nil {
+		return nil, notMocked("TrackingBeaconUpdate")
+	}
+	return m.TrackingBeaconUpdateFunc(ctx, id, req)
+}
+
+func (m *Mock) TrackingBeaconDelete(ctx context.Context, id string) (*eventbrite.TrackingBeacon, error) {
+	m.record("TrackingBeaconDelete", []interface{}{id})
+	if m.TrackingBeaconDeleteFunc == nil {
+		return nil, notMocked("TrackingBeaconDelete")
+	}
+	return m.TrackingBeaconDeleteFunc(ctx, id)
+}
+
+func (m *Mock) TrackingBeaconGetForEvent(ctx context.Context, eventId string, req *eventbrite.GetTrackingBeaconForEventRequest) (*eventbrite.TrackingBeacon, error) {
+	m.record("TrackingBeaconGetForEvent", []interface{}{eventId, req})
+	if m.TrackingBeaconGetForEventFunc == nil {
+		return nil, notMocked("TrackingBeaconGetForEvent")
+	}
+	return m.TrackingBeaconGetForEventFunc(ctx, eventId, req)
+}
+
+func (m *Mock) TrackingBeaconGetForUser(ctx context.Context, userId string, req *eventbrite.GetTrackingBeaconForUserRequest) (*eventbrite.TrackingBeacon, error) {
+	m.record("TrackingBeaconGetForUser", []interface{}{userId, req})
+	if m.TrackingBeaconGetForUserFunc == nil {
+		return nil, notMocked("TrackingBeaconGetForUser")
+	}
+	return m.TrackingBeaconGetForUserFunc(ctx, userId, req)
+}
+
+func (m *Mock) WebhookGet(ctx context.Context, id string) (*eventbrite.Webhook, error) {
+	m.record("WebhookGet", []interface{}{id})
+	if m.WebhookGetFunc == nil {
+		return nil, notMocked("WebhookGet")
+	}
+	return m.WebhookGetFunc(ctx, id)
+}
+
+func (m *Mock) WebhookDelete(ctx context.Context, id string) (*eventbrite.Webhook, error) {
+	m.record("WebhookDelete", []interface{}{id})
+	if m.WebhookDeleteFunc == nil {
+		return nil, notMocked("WebhookDelete")
+	}
+	return m.WebhookDeleteFunc(ctx, id)
+}
+
+func (m *Mock) Webhooks(ctx context.Context, req *eventbrite.WebhooksRequest) (*eventbrite.WebhooksResult, error) {
+	m.record("Webhooks", []interface{}{req})
+	if m.WebhooksFunc == nil {
+		return nil, notMocked("Webhooks")
+	}
+	return m.WebhooksFunc(ctx, req)
+}
+
+func (m *Mock) WebhookCreate(ctx context.Context, req *eventbrite.CreateWebhookRequest) (*eventbrite.Webhook, error) {
+	m.record("WebhookCreate", []interface{}{req})
+	if m.WebhookCreateFunc == nil {
+		return nil, notMocked("WebhookCreate")
+	}
+	return m.WebhookCreateFunc(ctx, req)
+}
@@ -0,0 +1,48 @@
+package eventbritetest_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/apzuk/go-eventbrite"
+	"github.com/apzuk/go-eventbrite/eventbritetest"
+)
+
+func TestMock(t *testing.T) {
+	m := &eventbritetest.Mock{
+		EventGetFunc: func(ctx context.Context, id string, expand ...eventbrite.Expansion) (*eventbrite.Event, error) {
+			e := &eventbrite.Event{}
+			e.Id = id
+			return e, nil
+		},
+	}
+	ctx := context.Background()
+
+	event, err := m.EventGet(ctx, "42", eventbrite.ExpandVenue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Id != "42" {
+		t.Errorf("got event %s, want 42", event.Id)
+	}
+
+	if _, err := m.EventDelete(ctx, "42"); !errors.Is(err, eventbritetest.ErrNotMocked) {
+		t.Errorf("got error %v for an operation without function, want ErrNotMocked", err)
+	}
+
+	want := []eventbritetest.MockCall{
+		{Operation: "EventGet", Args: []interface{}{"42", []eventbrite.Expansion{eventbrite.ExpandVenue}}},
+		{Operation: "EventDelete", Args: []interface{}{"42"}},
+	}
+	if got := m.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got calls %+v, want %+v", got, want)
+	}
+
+	m.Reset()
+	if got := m.Calls(); len(got) != 0 {
+		t.Errorf("got calls %+v after Reset", got)
+	}
+}
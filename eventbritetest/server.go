@@ -0,0 +1,284 @@
+// Package eventbritetest provides an in-memory fake of the Eventbrite API for end-to-end tests
+// of code built on the client, without network access.
+//
+//	srv := eventbritetest.NewServer()
+//	defer srv.Close()
+//
+//	id := srv.AddEvent(eventbrite.Event{Name: eventbrite.MultipartText{Html: "Gophercon"}})
+//	client, err := srv.Client()
+//	event, err := client.EventGet(ctx, id)
+//
+// The server keeps the objects it is seeded with and those created through the API, paginates
+// listings and answers with the error payloads of the API, e.g. NOT_FOUND and ARGUMENTS_ERROR.
+//
+// Code depending on the service interfaces of the client, e.g. an eventbrite.EventService, can
+// be given a Mock instead, answering with the functions of the test.
+package eventbritetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apzuk/go-eventbrite"
+)
+
+// DefaultToken is the OAuth token a server accepts unless configured with WithToken
+const DefaultToken = "eventbritetest-token"
+
+// DefaultPageSize is the number of objects per page of a listing unless configured with WithPageSize
+const DefaultPageSize = 50
+
+// UserID is the ID of the user owning every object of the server. The API alias "me" refers
+// to it as well.
+const UserID = "1"
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithToken sets the OAuth token the server accepts. Default is DefaultToken.
+func WithToken(token string) Option {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+// WithPageSize sets the number of objects per page of a listing. Default is DefaultPageSize.
+// It panics if n is not positive.
+func WithPageSize(n int) Option {
+	if n <= 0 {
+		panic(fmt.Sprintf("eventbritetest: invalid page size %d", n))
+	}
+	return func(s *Server) {
+		s.pageSize = n
+	}
+}
+
+// Server is a fake of the Eventbrite API listening on a local address. Requests must carry the
+// token of the server as a Bearer token.
+type Server struct {
+	*httptest.Server
+
+	token    string
+	pageSize int
+	routes   []route
+
+	mu          sync.Mutex
+	store       *store
+	rateLimited int
+	retryAfter  time.Duration
+}
+
+// NewServer starts and returns a new server. The caller should call Close when finished, to
+// shut it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		token:    DefaultToken,
+		pageSize: DefaultPageSize,
+		store:    newStore(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.routes = s.routeTable()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Token returns the OAuth token the server accepts
+func (s *Server) Token() string {
+	return s.token
+}
+
+// Client returns a client of the server authenticated with its token. The rate limiter of the
+// client is disabled; the options passed in are applied last.
+func (s *Server) Client(opts ...eventbrite.ClientOption) (*eventbrite.Client, error) {
+	return eventbrite.NewClient(append([]eventbrite.ClientOption{
+		eventbrite.WithBaseURL(s.URL),
+		eventbrite.WithToken(s.token),
+		eventbrite.WithRateLimits(eventbrite.RateLimits{}),
+	}, opts...)...)
+}
+
+// InjectRateLimit makes the server answer the next n requests with the HIT_RATE_LIMIT error
+// and a Retry-After header of retryAfter, rounded up to the second.
+func (s *Server) InjectRateLimit(n int, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateLimited = n
+	s.retryAfter = retryAfter
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	auth := r.Header.Get("Authorization")
+	switch {
+	case auth == "":
+		writeError(w, http.StatusUnauthorized, eventbrite.ErrKeyNoAuth, "An OAuth token is required for all requests.")
+		return
+	case !strings.HasPrefix(auth, "Bearer "):
+		writeError(w, http.StatusBadRequest, eventbrite.ErrKeyInvalidAuthHeader, "The Authorization header is not a Bearer token.")
+		return
+	case strings.TrimPrefix(auth, "Bearer ") != s.token:
+		writeError(w, http.StatusUnauthorized, eventbrite.ErrKeyInvalidAuth, "The OAuth token you provided was invalid.")
+		return
+	}
+
+	if s.rateLimited > 0 {
+		s.rateLimited--
+		seconds := int((s.retryAfter + time.Second - 1) / time.Second)
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+		writeError(w, http.StatusTooManyRequests, eventbrite.ErrKeyHitRateLimit, "Hit rate limit. Retry after "+strconv.Itoa(seconds)+" seconds.")
+		return
+	}
+
+	params, err := readParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, eventbrite.ErrKeyBadRequest, err.Error())
+		return
+	}
+
+	segments := splitPath(r.URL.Path)
+	found := false
+	for _, rt := range s.routes {
+		vars, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		found = true
+		if rt.method == r.Method {
+			rt.handle(w, &request{Request: r, vars: vars, params: params})
+			return
+		}
+	}
+	if found {
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Method "+r.Method+" is not allowed for this endpoint.")
+		return
+	}
+	writeError(w, http.StatusNotFound, eventbrite.ErrKeyNotFound, "The path you requested does not exist.")
+}
+
+// request is a request matched by a route
+type request struct {
+	*http.Request
+	// the values of the :name segments of the route pattern
+	vars map[string]string
+	// the flattened JSON body of the request
+	params params
+}
+
+type route struct {
+	method   string
+	segments []string
+	handle   func(w http.ResponseWriter, r *request)
+}
+
+// match matches the segments of a request path against the route pattern. Trailing slashes
+// are ignored, as the client is not consistent about them.
+func (rt route) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(rt.segments) {
+		return nil, false
+	}
+	vars := map[string]string{}
+	for i, seg := range rt.segments {
+		switch {
+		case strings.HasPrefix(seg, ":"):
+			vars[seg[1:]] = segments[i]
+		case seg != segments[i]:
+			return nil, false
+		}
+	}
+	return vars, true
+}
+
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error payload in the format of the API
+func writeError(w http.ResponseWriter, status int, key, description string) {
+	writeJSON(w, status, map[string]interface{}{
+		"status_code":       status,
+		"error":             key,
+		"error_description": description,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusNotFound, eventbrite.ErrKeyNotFound, fmt.Sprintf("The %s you requested (%s) does not exist.", kind, id))
+}
+
+// writeArgumentsError writes the ARGUMENTS_ERROR payload listing the rejected parameters
+func writeArgumentsError(w http.ResponseWriter, errs map[string][]string) {
+	var descriptions []string
+	for _, param := range sortedKeys(errs) {
+		descriptions = append(descriptions, param+" - "+strings.Join(errs[param], ", "))
+	}
+	writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+		"status_code":       http.StatusBadRequest,
+		"error":             eventbrite.ErrKeyArgumentsError,
+		"error_description": "There are errors with your arguments: " + strings.Join(descriptions, "; "),
+		"error_detail": map[string]interface{}{
+			eventbrite.ErrKeyArgumentsError: errs,
+		},
+	})
+}
+
+// writePage writes the page of the listing requested by the page query parameter, under key
+func (s *Server) writePage(w http.ResponseWriter, r *request, key string, items []object) {
+	page := 1
+	if v := r.URL.Query().Get("page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			writeArgumentsError(w, map[string][]string{"page": {"INVALID"}})
+			return
+		}
+		page = n
+	}
+
+	count := (len(items) + s.pageSize - 1) / s.pageSize
+	if page < 1 || (page > count && page != 1) {
+		writeError(w, http.StatusBadRequest, "BAD_PAGE", fmt.Sprintf("The page number %d is out of range (1-%d).", page, count))
+		return
+	}
+
+	start := (page - 1) * s.pageSize
+	end := start + s.pageSize
+	if end > len(items) {
+		end = len(items)
+	}
+	pageItems := items[start:end]
+	if pageItems == nil {
+		pageItems = []object{}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"pagination": map[string]interface{}{
+			"object_count":   len(items),
+			"page_number":    page,
+			"page_size":      s.pageSize,
+			"page_count":     count,
+			"has_more_items": page < count,
+		},
+		key: pageItems,
+	})
+}
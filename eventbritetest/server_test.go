@@ -0,0 +1,236 @@
+package eventbritetest_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/apzuk/go-eventbrite"
+	"github.com/apzuk/go-eventbrite/eventbritetest"
+)
+
+// newClient returns a client of srv closed at the end of the test
+func newClient(t *testing.T, srv *eventbritetest.Server, opts ...eventbrite.ClientOption) *eventbrite.Client {
+	t.Helper()
+
+	client, err := srv.Client(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestServerAuthorization(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   int
+	}{
+		{"no token", "", http.StatusUnauthorized},
+		{"not a bearer token", "Basic " + eventbritetest.DefaultToken, http.StatusBadRequest},
+		{"wrong token", "Bearer another-token", http.StatusUnauthorized},
+		{"token", "Bearer " + eventbritetest.DefaultToken, http.StatusOK},
+	}
+
+	srv := eventbritetest.NewServer()
+	defer srv.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, srv.URL+"/users/me/", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.want {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.want)
+			}
+		})
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	srv := eventbritetest.NewServer()
+	defer srv.Close()
+
+	other, err := eventbrite.NewClient(eventbrite.WithBaseURL(srv.URL), eventbrite.WithToken("another-token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+		want error
+	}{
+		{"unknown event", func() error {
+			_, err := newClient(t, srv).EventGet(ctx, "404")
+			return err
+		}, eventbrite.ErrNotFound},
+		{"wrong token", func() error {
+			_, err := other.User(ctx, "me")
+			return err
+		}, eventbrite.ErrUnauthorized},
+		{"missing parameter", func() error {
+			_, err := newClient(t, srv).WebhookCreate(ctx, &eventbrite.CreateWebhookRequest{})
+			return err
+		}, eventbrite.ErrArguments},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, tt.want) {
+				t.Errorf("got error %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestServerPagination(t *testing.T) {
+	srv := eventbritetest.NewServer(eventbritetest.WithPageSize(3))
+	defer srv.Close()
+
+	for i := 0; i < 7; i++ {
+		srv.AddEvent(eventbrite.Event{Name: eventbrite.MultipartText{Html: fmt.Sprint("Meetup ", i)}})
+	}
+	client := newClient(t, srv)
+
+	events, err := client.UserOwnedEventsIterator(context.Background(), "me", &eventbrite.UserOwnedEventsRequest{}).All(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 7 {
+		t.Fatalf("got %d events, want 7", len(events))
+	}
+	for i, e := range events {
+		if want := fmt.Sprint("Meetup ", i); e.Name.Html != want {
+			t.Errorf("event %d is %q, want %q", i, e.Name.Html, want)
+		}
+	}
+
+	res, err := client.UserOwnedEvents(context.Background(), "me", &eventbrite.UserOwnedEventsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := res.Pagination
+	if p.PageNumber != 1 || p.PageSize != 3 || p.PageCount != 3 || p.ObjectCount != 7 || !p.HasMoreItems {
+		t.Errorf("got pagination %+v", p)
+	}
+}
+
+func TestWithPageSizeInvalid(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("WithPageSize(%d) did not panic", n)
+				}
+			}()
+			eventbritetest.WithPageSize(n)
+		}()
+	}
+}
+
+func TestServerEventLifecycle(t *testing.T) {
+	srv := eventbritetest.NewServer()
+	defer srv.Close()
+	client := newClient(t, srv)
+	ctx := context.Background()
+
+	start := time.Date(2030, 1, 2, 18, 0, 0, 0, time.UTC)
+	created, err := client.EventCreate(ctx, &eventbrite.EventCreateRequest{
+		NameHtml:      "Gophercon",
+		StartUtc:      eventbrite.DateTime{Time: start},
+		StartTimezone: "UTC",
+		EndUtc:        eventbrite.DateTime{Time: start.Add(2 * time.Hour)},
+		EndTimezone:   "UTC",
+		Currency:      "EUR",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var event eventbrite.Event
+	if !srv.Lookup(eventbritetest.Events, created.Id, &event) {
+		t.Fatalf("event %s not stored", created.Id)
+	}
+	if event.Status != "draft" || event.Name.Text != "Gophercon" || event.Start.Utc != "2030-01-02T18:00:00Z" {
+		t.Errorf("got event %q %s starting %v", event.Name.Text, event.Status, event.Start.Utc)
+	}
+
+	if res, err := client.EventPublish(ctx, created.Id); err != nil || !res.Published {
+		t.Fatalf("publish: %v %+v", err, res)
+	}
+	srv.Lookup(eventbritetest.Events, created.Id, &event)
+	if event.Status != "live" {
+		t.Errorf("got status %s after publishing, want live", event.Status)
+	}
+
+	if res, err := client.EventDelete(ctx, created.Id); err != nil || !res.Deleted {
+		t.Fatalf("delete: %v %+v", err, res)
+	}
+	if srv.Lookup(eventbritetest.Events, created.Id, &event) {
+		t.Error("event still stored after deleting it")
+	}
+}
+
+func TestServerStampsSeededObjects(t *testing.T) {
+	srv := eventbritetest.NewServer()
+	defer srv.Close()
+
+	created := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	unset := srv.AddEvent(eventbrite.Event{})
+	set := srv.AddEvent(eventbrite.Event{Created: eventbrite.DateTime{Time: created}})
+
+	client := newClient(t, srv)
+	event, err := client.EventGet(context.Background(), unset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Created.IsZero() || time.Since(event.Created.Time) > time.Minute {
+		t.Errorf("got creation time %v for an event created without one, want now", event.Created)
+	}
+
+	if event, err = client.EventGet(context.Background(), set); err != nil {
+		t.Fatal(err)
+	}
+	if !event.Created.Time.Equal(created) {
+		t.Errorf("got creation time %v, want %v", event.Created, created)
+	}
+}
+
+func TestServerInjectRateLimit(t *testing.T) {
+	srv := eventbritetest.NewServer()
+	defer srv.Close()
+	id := srv.AddEvent(eventbrite.Event{})
+
+	client := newClient(t, srv, eventbrite.WithRetryPolicy(eventbrite.RetryPolicy{
+		MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Multiplier: 1,
+	}))
+	ctx := context.Background()
+
+	srv.InjectRateLimit(1, time.Millisecond)
+	if _, err := client.EventGet(ctx, id); err != nil {
+		t.Errorf("got error %v, want the request retried once", err)
+	}
+
+	srv.InjectRateLimit(2, time.Millisecond)
+	if _, err := client.EventGet(ctx, id); !errors.Is(err, eventbrite.ErrRateLimited) {
+		t.Errorf("got error %v, want ErrRateLimited", err)
+	}
+}
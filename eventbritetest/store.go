@@ -0,0 +1,415 @@
+package eventbritetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apzuk/go-eventbrite"
+)
+
+// Resource names a kind of object kept by the server
+type Resource string
+
+// Resources kept by the server, named after their key in the listings of the API
+const (
+	Events        Resource = "events"
+	TicketClasses Resource = "ticket_classes"
+	Orders        Resource = "orders"
+	Attendees     Resource = "attendees"
+	Venues        Resource = "venues"
+	Organizers    Resource = "organizers"
+	Webhooks      Resource = "webhooks"
+	Discounts     Resource = "discounts"
+	TicketGroups  Resource = "ticket_groups"
+)
+
+const timeFormat = "2006-01-02T15:04:05Z"
+
+// zeroDateTime and zeroDate are the encodings of an unset DateTime and Date
+const (
+	zeroDateTime = "0001-01-01T00:00:00Z"
+	zeroDate     = "0001-01-01"
+)
+
+// object is an API object as it is encoded in responses
+type object map[string]interface{}
+
+// collection keeps the objects of a resource in the order they were added
+type collection struct {
+	ids   []string
+	items map[string]object
+}
+
+func (c *collection) get(id string) (object, bool) {
+	o, ok := c.items[id]
+	return o, ok
+}
+
+func (c *collection) put(id string, o object) {
+	if _, ok := c.items[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.items[id] = o
+}
+
+func (c *collection) remove(id string) {
+	delete(c.items, id)
+	for i, v := range c.ids {
+		if v == id {
+			c.ids = append(c.ids[:i:i], c.ids[i+1:]...)
+			return
+		}
+	}
+}
+
+// list returns the objects for which keep returns true, or all of them when keep is nil
+func (c *collection) list(keep func(object) bool) []object {
+	var res []object
+	for _, id := range c.ids {
+		if o := c.items[id]; keep == nil || keep(o) {
+			res = append(res, o)
+		}
+	}
+	return res
+}
+
+type store struct {
+	collections map[Resource]*collection
+	lastID      int
+	user        object
+}
+
+func newStore() *store {
+	s := &store{
+		collections: map[Resource]*collection{},
+		lastID:      1000,
+		user: object{
+			"id":         UserID,
+			"name":       "Test User",
+			"first_name": "Test",
+			"last_name":  "User",
+			"emails": []interface{}{
+				map[string]interface{}{"email": "test@example.com", "verified": true, "primary": true},
+			},
+		},
+	}
+	for _, r := range []Resource{Events, TicketClasses, Orders, Attendees, Venues, Organizers, Webhooks, Discounts, TicketGroups} {
+		s.collections[r] = &collection{items: map[string]object{}}
+	}
+	return s
+}
+
+// add stores the object under its id, assigning a new one if it has none, and returns the id
+func (s *store) add(r Resource, o object) string {
+	c := s.collections[r]
+	id, _ := o["id"].(string)
+	for id == "" {
+		s.lastID++
+		if _, ok := c.items[strconv.Itoa(s.lastID)]; !ok {
+			id = strconv.Itoa(s.lastID)
+		}
+	}
+	o["id"] = id
+	stamp(o)
+	c.put(id, o)
+
+	return id
+}
+
+// stamp sets the creation and change times of an object that has none
+func stamp(o object) {
+	now := time.Now().UTC().Format(timeFormat)
+	for _, key := range []string{"created", "changed"} {
+		if isEmpty(o[key]) {
+			o[key] = now
+		}
+	}
+}
+
+// AddEvent stores an event and returns its ID. The event is live unless it has a status.
+func (s *Server) AddEvent(e eventbrite.Event) string {
+	o := toObject(e)
+	if isEmpty(o["status"]) {
+		o["status"] = "live"
+	}
+	return s.add(Events, o)
+}
+
+// AddSeries stores a repeating event series parent and its occurrences, and returns the ID
+// of the series
+func (s *Server) AddSeries(parent eventbrite.Event, occurrences ...eventbrite.Event) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := toObject(parent)
+	if isEmpty(o["status"]) {
+		o["status"] = "live"
+	}
+	o["is_series_parent"] = true
+	id := s.store.add(Events, o)
+	o["series_id"] = id
+	for _, e := range occurrences {
+		child := toObject(e)
+		child["status"] = o["status"]
+		child["is_series"] = true
+		child["series_id"] = id
+		s.store.add(Events, child)
+	}
+	return id
+}
+
+// AddTicketClass stores a ticket class of an event and returns its ID
+func (s *Server) AddTicketClass(eventID string, tc eventbrite.TicketClass) string {
+	o := toObject(tc)
+	o["event_id"] = eventID
+	return s.add(TicketClasses, o)
+}
+
+// AddOrder stores an order and returns its ID
+func (s *Server) AddOrder(o eventbrite.Order) string {
+	return s.add(Orders, toObject(o))
+}
+
+// AddAttendee stores an attendee and returns its ID. Link it to its event and order through
+// EventID and OrderID.
+func (s *Server) AddAttendee(a eventbrite.Attendee) string {
+	return s.add(Attendees, toObject(a))
+}
+
+// AddVenue stores a venue and returns its ID
+func (s *Server) AddVenue(v eventbrite.Venue) string {
+	return s.add(Venues, toObject(v))
+}
+
+// AddOrganizer stores an organizer and returns its ID
+func (s *Server) AddOrganizer(o eventbrite.Organizer) string {
+	return s.add(Organizers, toObject(o))
+}
+
+// AddWebhook stores a webhook and returns its ID
+func (s *Server) AddWebhook(w eventbrite.Webhook) string {
+	return s.add(Webhooks, toObject(w))
+}
+
+// AddDiscount stores a discount and returns its ID
+func (s *Server) AddDiscount(d eventbrite.CrossEventDiscount) string {
+	return s.add(Discounts, toObject(d))
+}
+
+// AddTicketGroup stores a ticket group and returns its ID. The group is live unless it has
+// a status.
+func (s *Server) AddTicketGroup(g eventbrite.TicketGroup) string {
+	o := toObject(g)
+	if isEmpty(o["status"]) {
+		o["status"] = "live"
+	}
+	return s.add(TicketGroups, o)
+}
+
+func (s *Server) add(r Resource, o object) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.store.add(r, o)
+}
+
+// Lookup decodes the stored object of the resource with the given ID into v, e.g. to check
+// the effect of a client call. It reports whether the object exists.
+func (s *Server) Lookup(r Resource, id string, v interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.store.collections[r]
+	if !ok {
+		return false
+	}
+	o, ok := c.get(id)
+	if !ok {
+		return false
+	}
+	data, err := json.Marshal(o)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(fmt.Sprintf("eventbritetest: decoding %s %s: %v", r, id, err))
+	}
+	return true
+}
+
+// Count returns the number of stored objects of the resource
+func (s *Server) Count(r Resource) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c, ok := s.store.collections[r]; ok {
+		return len(c.ids)
+	}
+	return 0
+}
+
+// toObject returns the object as the API encodes v
+func toObject(v interface{}) object {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("eventbritetest: encoding %T: %v", v, err))
+	}
+	o := object{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&o); err != nil {
+		panic(fmt.Sprintf("eventbritetest: encoding %T: %v", v, err))
+	}
+	dropZeroDates(o)
+	return o
+}
+
+// dropZeroDates removes the unset dates of an object encoded from a value of the client, which
+// encodes them as the zero time
+func dropZeroDates(o map[string]interface{}) {
+	for k, v := range o {
+		switch v := v.(type) {
+		case string:
+			if v == zeroDateTime || v == zeroDate {
+				delete(o, k)
+			}
+		case map[string]interface{}:
+			dropZeroDates(v)
+		}
+	}
+}
+
+// params are the parameters of a request body, keyed by their dotted name such as
+// "event.name.html". Bodies are accepted both with dotted keys and as nested objects.
+type params map[string]interface{}
+
+func readParams(r *http.Request) (params, error) {
+	p := params{}
+	if r.Body == nil || r.Method == http.MethodGet {
+		return p, nil
+	}
+
+	var body interface{}
+	d := json.NewDecoder(r.Body)
+	d.UseNumber()
+	if err := d.Decode(&body); err != nil {
+		if errors.Is(err, io.EOF) {
+			return p, nil
+		}
+		return nil, fmt.Errorf("The request body is not valid JSON: %v", err)
+	}
+	switch body := body.(type) {
+	case nil:
+	case map[string]interface{}:
+		flatten(p, "", body)
+	default:
+		return nil, errors.New("The request body must be a JSON object.")
+	}
+
+	return p, nil
+}
+
+func flatten(p params, prefix string, m map[string]interface{}) {
+	for k, v := range m {
+		if nested, ok := v.(map[string]interface{}); ok && len(nested) > 0 {
+			flatten(p, prefix+k+".", nested)
+			continue
+		}
+		p[prefix+k] = v
+	}
+}
+
+// str returns the parameter as a string
+func (p params) str(key string) string {
+	switch v := p[key].(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// object returns the non empty parameters starting with prefix as a nested object, without
+// the prefix
+func (p params) object(prefix string) object {
+	o := object{}
+	for key, v := range p {
+		if isEmpty(v) {
+			continue
+		}
+		if prefix != "" {
+			if !strings.HasPrefix(key, prefix+".") {
+				continue
+			}
+			key = strings.TrimPrefix(key, prefix+".")
+		}
+
+		m := map[string]interface{}(o)
+		parts := strings.Split(key, ".")
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := m[part].(map[string]interface{})
+			if !ok {
+				next = map[string]interface{}{}
+				m[part] = next
+			}
+			m = next
+		}
+		m[parts[len(parts)-1]] = v
+	}
+	return o
+}
+
+// missing returns the MISSING error of each required parameter without a value
+func (p params) missing(keys ...string) map[string][]string {
+	errs := map[string][]string{}
+	for _, key := range keys {
+		if isEmpty(p[key]) {
+			errs[key] = []string{"MISSING"}
+		}
+	}
+	return errs
+}
+
+// isEmpty reports whether a value is absent, i.e. null or an empty string
+func isEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	}
+	return false
+}
+
+// merge copies the values of src into dst, merging nested objects
+func merge(dst, src map[string]interface{}) {
+	for k, v := range src {
+		nested, ok := v.(map[string]interface{})
+		if d, isObject := dst[k].(map[string]interface{}); ok && isObject {
+			merge(d, nested)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,64 @@
+package eventbrite
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+// Expansion names a related object to include in a response instead of only its ID. Objects
+// nested in an expansion are expanded with a dotted path, e.g. Expansion("event.venue").
+//
+// https://www.eventbrite.com/developer/v3/api_overview/expansions/
+type Expansion string
+
+// Expansions of events
+const (
+	ExpandVenue              Expansion = "venue"
+	ExpandOrganizer          Expansion = "organizer"
+	ExpandFormat             Expansion = "format"
+	ExpandCategory           Expansion = "category"
+	ExpandSubcategory        Expansion = "subcategory"
+	ExpandTicketClasses      Expansion = "ticket_classes"
+	ExpandTicketAvailability Expansion = "ticket_availability"
+	ExpandBookmarkInfo       Expansion = "bookmark_info"
+	ExpandRefundPolicy       Expansion = "refund_policy"
+)
+
+// Expansions of orders
+const (
+	ExpandEvent          Expansion = "event"
+	ExpandAttendees      Expansion = "attendees"
+	ExpandRefundRequests Expansion = "refund_requests"
+)
+
+// Expansions of attendees. Attendees also support ExpandEvent.
+const (
+	ExpandOrder           Expansion = "order"
+	ExpandPromotionalCode Expansion = "promotional_code"
+)
+
+type expandKey struct{}
+
+// withExpansions returns a context whose GET request expands the given objects
+func withExpansions(ctx context.Context, expand []Expansion) context.Context {
+	if len(expand) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, expandKey{}, expand)
+}
+
+// setExpansions adds the expansions requested through withExpansions to the query
+func setExpansions(ctx context.Context, q url.Values) {
+	expand, ok := ctx.Value(expandKey{}).([]Expansion)
+	if !ok {
+		return
+	}
+
+	names := make([]string, len(expand))
+	for i, e := range expand {
+		names[i] = string(e)
+	}
+	q.Set("expand", strings.Join(names, ","))
+}
@@ -6,6 +6,8 @@ import (
 
 // FormatResult is the response structure for available formats
 type FormatResult struct {
+	RawFields
+
 	Locale  string `json:"locale"`
 	Formats []Format
 }
@@ -15,6 +17,8 @@ type FormatResult struct {
 //
 // https://www.eventbrite.com/developer/v3/response_formats/event/#ebapi-format
 type Format struct {
+	RawFields
+
 	// Format ID
 	ID string `json:"id"`
 	// The format name
@@ -0,0 +1,117 @@
+// Command rawgen generates the JSON methods of the response objects keeping their raw fields,
+// in raw_gen.go.
+//
+// It is run by go generate from the root of the module:
+//
+//	go generate
+//
+// The response objects are the struct types of the package embedding RawFields.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const header = "// Code generated by internal/rawgen. DO NOT EDIT.\n\n"
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("rawgen: ")
+
+	dir := "."
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+
+	types, err := parse(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := write(filepath.Join(dir, "raw_gen.go"), methods(types)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse returns the names of the struct types of the package in dir embedding RawFields, sorted
+func parse(dir string) ([]string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != "raw_gen.go"
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	pkg, ok := pkgs["eventbrite"]
+	if !ok {
+		return nil, fmt.Errorf("no eventbrite package in %s", dir)
+	}
+
+	var types []string
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if st, ok := ts.Type.(*ast.StructType); ok && embedsRawFields(st) {
+					types = append(types, ts.Name.Name)
+				}
+			}
+		}
+	}
+	if len(types) == 0 {
+		return nil, fmt.Errorf("no type embeds RawFields in %s", dir)
+	}
+	sort.Strings(types)
+	return types, nil
+}
+
+func embedsRawFields(st *ast.StructType) bool {
+	for _, field := range st.Fields.List {
+		if id, ok := field.Type.(*ast.Ident); ok && len(field.Names) == 0 && id.Name == "RawFields" {
+			return true
+		}
+	}
+	return false
+}
+
+func methods(types []string) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package eventbrite\n")
+
+	for _, t := range types {
+		recv := strings.ToLower(t[:1])
+		local := strings.ToLower(t[:1]) + t[1:]
+
+		fmt.Fprintf(&b, "\nfunc (%s *%s) UnmarshalJSON(data []byte) error {\n", recv, t)
+		fmt.Fprintf(&b, "\ttype %s %s\n", local, t)
+		fmt.Fprintf(&b, "\treturn unmarshalRaw(data, (*%s)(%s), &%s.RawFields)\n}\n", local, recv, recv)
+
+		fmt.Fprintf(&b, "\nfunc (%s %s) MarshalJSON() ([]byte, error) {\n", recv, t)
+		fmt.Fprintf(&b, "\ttype %s %s\n", local, t)
+		fmt.Fprintf(&b, "\treturn marshalRaw(%s(%s), %s.RawFields)\n}\n", local, recv, recv)
+	}
+	return b.Bytes()
+}
+
+func write(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return ioutil.WriteFile(path, formatted, 0644)
+}
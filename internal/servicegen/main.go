@@ -0,0 +1,365 @@
+// Command servicegen generates the decorators of the service interfaces of services.go, in
+// services_gen.go, and the Mock of the eventbritetest package, in eventbritetest/mock_gen.go.
+//
+// It is run by go generate from the root of the module:
+//
+//	go generate
+//
+// The operations are read from the interfaces embedded in Services, in order. An operation is
+// mutating when it is listed in the mutatingOperations table of services.go, which may only
+// list operations.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = "// Code generated by internal/servicegen. DO NOT EDIT.\n\n"
+
+type param struct {
+	name     string
+	typ      ast.Expr
+	variadic bool
+}
+
+type operation struct {
+	name     string
+	params   []param
+	result   ast.Expr // the type pointed to by the first result
+	mutating bool
+}
+
+type service struct {
+	name       string
+	operations []operation
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("servicegen: ")
+
+	dir := "."
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+
+	services, err := parse(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := write(filepath.Join(dir, "services_gen.go"), decorators(services)); err != nil {
+		log.Fatal(err)
+	}
+	if err := write(filepath.Join(dir, "eventbritetest", "mock_gen.go"), mock(services)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse reads the services of the package in dir
+func parse(dir string) ([]service, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != "services_gen.go"
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	pkg, ok := pkgs["eventbrite"]
+	if !ok {
+		return nil, fmt.Errorf("no eventbrite package in %s", dir)
+	}
+
+	interfaces := map[string]*ast.InterfaceType{}
+	methods := map[string]*ast.FuncDecl{}
+	var mutating map[string]bool
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						if it, ok := spec.Type.(*ast.InterfaceType); ok {
+							interfaces[spec.Name.Name] = it
+						}
+					case *ast.ValueSpec:
+						if len(spec.Names) == 1 && spec.Names[0].Name == "mutatingOperations" {
+							if mutating, err = table(spec); err != nil {
+								return nil, fmt.Errorf("mutatingOperations: %v", err)
+							}
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv != nil && isClient(decl.Recv.List[0].Type) {
+					methods[decl.Name.Name] = decl
+				}
+			}
+		}
+	}
+
+	root, ok := interfaces["Services"]
+	if !ok {
+		return nil, fmt.Errorf("no Services interface in %s", dir)
+	}
+	if mutating == nil {
+		return nil, fmt.Errorf("no mutatingOperations table in %s", dir)
+	}
+
+	var services []service
+	for _, embedded := range root.Methods.List {
+		name := embedded.Type.(*ast.Ident).Name
+		it, ok := interfaces[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown service %s", name)
+		}
+
+		s := service{name: name}
+		for _, m := range it.Methods.List {
+			op, err := newOperation(m.Names[0].Name, m.Type.(*ast.FuncType), methods)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", name, m.Names[0].Name, err)
+			}
+			op.mutating = mutating[op.name]
+			delete(mutating, op.name)
+			s.operations = append(s.operations, op)
+		}
+		services = append(services, s)
+	}
+	if len(mutating) > 0 {
+		var unknown []string
+		for name := range mutating {
+			unknown = append(unknown, name)
+		}
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("mutatingOperations: unknown operations %s", strings.Join(unknown, ", "))
+	}
+	return services, nil
+}
+
+// table reads a map[string]bool literal, keeping the names set to true
+func table(spec *ast.ValueSpec) (map[string]bool, error) {
+	if len(spec.Values) != 1 {
+		return nil, fmt.Errorf("want a map literal")
+	}
+	lit, ok := spec.Values[0].(*ast.CompositeLit)
+	if !ok {
+		return nil, fmt.Errorf("want a map literal")
+	}
+	names := map[string]bool{}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, fmt.Errorf("want key: value elements")
+		}
+		key, ok := kv.Key.(*ast.BasicLit)
+		if !ok || key.Kind != token.STRING {
+			return nil, fmt.Errorf("want string keys")
+		}
+		value, ok := kv.Value.(*ast.Ident)
+		if !ok || (value.Name != "true" && value.Name != "false") {
+			return nil, fmt.Errorf("want bool values")
+		}
+		name, err := strconv.Unquote(key.Value)
+		if err != nil {
+			return nil, err
+		}
+		if value.Name == "true" {
+			names[name] = true
+		}
+	}
+	return names, nil
+}
+
+func newOperation(name string, ft *ast.FuncType, methods map[string]*ast.FuncDecl) (operation, error) {
+	op := operation{name: name}
+
+	for i, field := range ft.Params.List {
+		if i == 0 {
+			// the context
+			continue
+		}
+		typ, variadic := field.Type, false
+		if e, ok := typ.(*ast.Ellipsis); ok {
+			typ, variadic = e.Elt, true
+		}
+		for _, n := range field.Names {
+			op.params = append(op.params, param{name: n.Name, typ: typ, variadic: variadic})
+		}
+	}
+
+	if ft.Results == nil || len(ft.Results.List) != 2 {
+		return op, fmt.Errorf("want (*T, error) results")
+	}
+	star, ok := ft.Results.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return op, fmt.Errorf("want (*T, error) results")
+	}
+	op.result = star.X
+
+	if _, ok := methods[name]; !ok {
+		return op, fmt.Errorf("not implemented by Client")
+	}
+	return op, nil
+}
+
+func isClient(e ast.Expr) bool {
+	star, ok := e.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	id, ok := star.X.(*ast.Ident)
+	return ok && id.Name == "Client"
+}
+
+// typeString prints e, qualifying the exported identifiers of the package with qualifier
+func typeString(e ast.Expr, qualifier string) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if qualifier != "" && ast.IsExported(e.Name) {
+			return qualifier + "." + e.Name
+		}
+		return e.Name
+	case *ast.StarExpr:
+		return "*" + typeString(e.X, qualifier)
+	case *ast.ArrayType:
+		return "[]" + typeString(e.Elt, qualifier)
+	case *ast.MapType:
+		return "map[" + typeString(e.Key, qualifier) + "]" + typeString(e.Value, qualifier)
+	case *ast.SelectorExpr:
+		return typeString(e.X, "") + "." + e.Sel.Name
+	case *ast.InterfaceType:
+		return "interface{}"
+	}
+	panic(fmt.Sprintf("unsupported type %T", e))
+}
+
+// signature prints the parameters and results of op
+func (op operation) signature(qualifier string) string {
+	params := []string{"ctx context.Context"}
+	for _, p := range op.params {
+		t := typeString(p.typ, qualifier)
+		if p.variadic {
+			t = "..." + t
+		}
+		params = append(params, p.name+" "+t)
+	}
+	return fmt.Sprintf("(%s) (*%s, error)", strings.Join(params, ", "), typeString(op.result, qualifier))
+}
+
+// args prints the arguments forwarding the context and the parameters of op
+func (op operation) args() string {
+	args := []string{"ctx"}
+	for _, p := range op.params {
+		if p.variadic {
+			args = append(args, p.name+"...")
+		} else {
+			args = append(args, p.name)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// values prints the parameters of op as a []interface{}
+func (op operation) values() string {
+	var values []string
+	for _, p := range op.params {
+		values = append(values, p.name)
+	}
+	return "[]interface{}{" + strings.Join(values, ", ") + "}"
+}
+
+func decorators(services []service) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package eventbrite\n\nimport \"golang.org/x/net/context\"\n\n")
+
+	b.WriteString("// Decorate wraps next with the interceptors, the first one being the outermost\n")
+	b.WriteString("func Decorate(next Services, interceptors ...Interceptor) Services {\n")
+	b.WriteString("\treturn struct {\n")
+	for _, s := range services {
+		fmt.Fprintf(&b, "\t\t%s\n", s.name)
+	}
+	b.WriteString("\t}{\n")
+	for _, s := range services {
+		fmt.Fprintf(&b, "\t\tDecorate%s(next, interceptors...),\n", s.name)
+	}
+	b.WriteString("\t}\n}\n")
+
+	b.WriteString("\n// operations are the names of the operations of Services\n")
+	b.WriteString("var operations = map[string]bool{\n")
+	for _, s := range services {
+		for _, op := range s.operations {
+			fmt.Fprintf(&b, "\t%q: true,\n", op.name)
+		}
+	}
+	b.WriteString("}\n")
+
+	for _, s := range services {
+		dec := strings.ToLower(s.name[:1]) + s.name[1:] + "Decorator"
+
+		fmt.Fprintf(&b, "\n// Decorate%s wraps next with the interceptors, the first one being the outermost\n", s.name)
+		fmt.Fprintf(&b, "func Decorate%[1]s(next %[1]s, interceptors ...Interceptor) %[1]s {\n", s.name)
+		fmt.Fprintf(&b, "\treturn &%s{decorator{interceptors}, next}\n}\n\n", dec)
+		fmt.Fprintf(&b, "type %s struct {\n\tdecorator\n\tnext %s\n}\n", dec, s.name)
+
+		for _, op := range s.operations {
+			fmt.Fprintf(&b, "\nfunc (d *%s) %s%s {\n", dec, op.name, op.signature(""))
+			fmt.Fprintf(&b, "\tcall := &Call{Operation: %q, Args: %s, Mutating: %t, newResult: func() interface{} { return new(%s) }}\n",
+				op.name, op.values(), op.mutating, typeString(op.result, ""))
+			b.WriteString("\tres, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {\n")
+			fmt.Fprintf(&b, "\t\treturn d.next.%s(%s)\n\t})\n", op.name, op.args())
+			fmt.Fprintf(&b, "\treturn decoratedResult[%s](call, res, err)\n}\n", typeString(op.result, ""))
+		}
+	}
+	return b.Bytes()
+}
+
+func mock(services []service) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package eventbritetest\n\nimport (\n\t\"golang.org/x/net/context\"\n\n\t\"github.com/apzuk/go-eventbrite\"\n)\n\n")
+
+	b.WriteString("// Mock implements eventbrite.Services with the functions of its fields, one per operation,\n")
+	b.WriteString("// e.g. EventGetFunc for EventGet. The operations without a function fail with ErrNotMocked.\n")
+	b.WriteString("// The calls are recorded, see Calls.\n")
+	b.WriteString("type Mock struct {\n\trecorder\n")
+	for _, s := range services {
+		fmt.Fprintf(&b, "\n\t// %s\n", s.name)
+		for _, op := range s.operations {
+			fmt.Fprintf(&b, "\t%sFunc func%s\n", op.name, op.signature("eventbrite"))
+		}
+	}
+	b.WriteString("}\n")
+
+	for _, s := range services {
+		for _, op := range s.operations {
+			fmt.Fprintf(&b, "\nfunc (m *Mock) %s%s {\n", op.name, op.signature("eventbrite"))
+			fmt.Fprintf(&b, "\tm.record(%q, %s)\n", op.name, op.values())
+			fmt.Fprintf(&b, "\tif m.%sFunc == nil {\n\t\treturn nil, notMocked(%q)\n\t}\n", op.name, op.name)
+			fmt.Fprintf(&b, "\treturn m.%sFunc(%s)\n}\n", op.name, op.args())
+		}
+	}
+	return b.Bytes()
+}
+
+func write(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return ioutil.WriteFile(path, formatted, 0644)
+}
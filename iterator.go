@@ -0,0 +1,76 @@
+package eventbrite
+
+import "golang.org/x/net/context"
+
+// Iterator walks all the pages of a paginated listing, e.g. the events of EventSearchIterator.
+// Pages are fetched when the previous one is exhausted, so iterating respects the rate limiter
+// and the context.
+//
+//	it := client.EventSearchIterator(ctx, req)
+//	for it.Next() {
+//		event := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		// the iteration stopped on err
+//	}
+type Iterator[T any] struct {
+	pager[T]
+	items []T
+	cur   *T
+}
+
+func newIterator[T any](ctx context.Context, fetch func(ctx context.Context) ([]T, Pagination, error)) *Iterator[T] {
+	return &Iterator[T]{pager: newPager(ctx, fetch)}
+}
+
+// Prefetch makes the iterator fetch up to workers pages concurrently once the number of pages
+// is known from the first one. Pages are still yielded in order and every request waits on the
+// rate limiter. It must be called before the first call to Next.
+func (it *Iterator[T]) Prefetch(workers int) *Iterator[T] {
+	it.setPrefetch(workers)
+	return it
+}
+
+// Next advances to the next item, fetching the following page when needed. It returns false
+// when all pages were read or on error; check Err to tell them apart.
+func (it *Iterator[T]) Next() bool {
+	for len(it.items) == 0 {
+		items, ok := it.nextPage()
+		if !ok {
+			it.cur = nil
+			return false
+		}
+		it.items = items
+	}
+	it.cur = &it.items[0]
+	it.items = it.items[1:]
+	return true
+}
+
+// Value returns the current item
+func (it *Iterator[T]) Value() *T {
+	return it.cur
+}
+
+// Close stops the iteration and cancels the page requests still in flight: Next returns false
+// afterwards. It is only needed when an iterator using Prefetch is not read to the end, and may
+// be called several times.
+func (it *Iterator[T]) Close() {
+	it.items, it.cur = nil, nil
+	it.done = true
+	it.finish()
+}
+
+// All collects the remaining items, stopping after max items. A max of 0 collects them all.
+// The iterator is closed once max items were collected.
+func (it *Iterator[T]) All(max int) ([]T, error) {
+	var all []T
+	for it.Next() {
+		all = append(all, *it.cur)
+		if limitReached(len(all), max) {
+			it.Close()
+			break
+		}
+	}
+	return all, it.Err()
+}
@@ -0,0 +1,279 @@
+package eventbrite
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// attendeePages serves pageCount pages of two attendees each, numbered or by continuation
+func attendeePages(pageCount int, continuation bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page := 1
+		if continuation {
+			if c := r.URL.Query().Get("continuation"); c != "" {
+				page, _ = strconv.Atoi(c)
+			}
+		} else if p := r.URL.Query().Get("page"); p != "" {
+			page, _ = strconv.Atoi(p)
+		}
+
+		next := ""
+		if continuation && page < pageCount {
+			next = strconv.Itoa(page + 1)
+		}
+		count := pageCount
+		if continuation {
+			count = 0
+		}
+		fmt.Fprintf(w, `{"pagination": {"page_number": %d, "page_count": %d, "has_more_items": %t, "continuation": %q},
+			"attendees": [{"order_id": "%d-a"}, {"order_id": "%d-b"}]}`, page, count, page < pageCount, next, page, page)
+	}
+}
+
+func orderIDs(attendees []Attendee) []string {
+	var ids []string
+	for _, a := range attendees {
+		ids = append(ids, a.OrderID)
+	}
+	return ids
+}
+
+func TestIterator(t *testing.T) {
+	tests := []struct {
+		name         string
+		continuation bool
+		max          int
+		want         []string
+	}{
+		{"page numbers", false, 0, []string{"1-a", "1-b", "2-a", "2-b", "3-a", "3-b"}},
+		{"continuation", true, 0, []string{"1-a", "1-b", "2-a", "2-b", "3-a", "3-b"}},
+		{"max", false, 3, []string{"1-a", "1-b", "2-a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, attendeePages(3, tt.continuation))
+
+			all, err := c.UserEventAttendeesIterator(context.Background(), "me", &UserEventAttendeesRequest{}).All(tt.max)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := orderIDs(all); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListingIterators(t *testing.T) {
+	// the key of the list in the response, by path
+	keys := map[string]string{
+		"/series/1/events/":           "events",
+		"/users/me/events/":           "events",
+		"/users/me/ticket_groups/":    "ticket_groups",
+		"/events/1/questions/":        "questions",
+		"/events/1/canned_questions/": "questions",
+		"/users/me/notifications/":    "notifications",
+	}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		fmt.Fprintf(w, `{"pagination": {"page_number": %d, "page_count": 2, "has_more_items": %t}, %q: [{}, {}]}`,
+			page, page < 2, keys[r.URL.Path])
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		all  func() (int, error)
+	}{
+		{"series events", func() (int, error) {
+			all, err := c.EventSeriesEventsIterator(ctx, "1", nil).All(0)
+			return len(all), err
+		}},
+		{"user events", func() (int, error) {
+			all, err := c.UserEventsIterator(ctx, "me", UserEventsRequest{}).All(0)
+			return len(all), err
+		}},
+		{"user ticket groups", func() (int, error) {
+			all, err := c.UserTicketGroupsIterator(ctx, "me", nil).All(0)
+			return len(all), err
+		}},
+		{"questions", func() (int, error) {
+			all, err := c.EventGetQuestionsIterator(ctx, "1", nil).All(0)
+			return len(all), err
+		}},
+		{"canned questions", func() (int, error) {
+			all, err := c.EventGetCannedQuestionsIterator(ctx, "1", nil).All(0)
+			return len(all), err
+		}},
+		{"notifications", func() (int, error) {
+			all, err := c.NotificationsIterator(ctx).All(0)
+			return len(all), err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := tt.all()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != 4 {
+				t.Errorf("got %d items, want the 4 items of the 2 pages", n)
+			}
+		})
+	}
+}
+
+func TestIteratorNextValue(t *testing.T) {
+	c := newTestClient(t, attendeePages(2, false))
+
+	it := c.UserEventAttendeesIterator(context.Background(), "me", &UserEventAttendeesRequest{})
+	if it.Value() != nil {
+		t.Error("value before Next")
+	}
+	var got []string
+	for it.Next() {
+		got = append(got, it.Value().OrderID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if it.Value() != nil {
+		t.Error("value after the last item")
+	}
+	if want := []string{"1-a", "1-b", "2-a", "2-b"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestIteratorError(t *testing.T) {
+	pages := attendeePages(3, false)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"status_code": 404, "error": "NOT_FOUND", "error_description": "gone"}`)
+			return
+		}
+		pages(w, r)
+	})
+
+	it := c.UserEventAttendeesIterator(context.Background(), "me", &UserEventAttendeesRequest{})
+	all, err := it.All(0)
+	if len(all) != 2 {
+		t.Errorf("got %d attendees, want the 2 of the first page", len(all))
+	}
+	if err == nil {
+		t.Fatal("no error")
+	}
+	if it.Next() {
+		t.Error("Next after an error")
+	}
+}
+
+// blockingPages returns the fetch of a listing of pageCount pages, blocking on every page after
+// the first until its context is cancelled, which is reported on cancelled
+func blockingPages(pageCount int, cancelled chan<- int) func(ctx context.Context) ([]int, Pagination, error) {
+	return func(ctx context.Context) ([]int, Pagination, error) {
+		page := ctx.Value(pageKey{}).(pageParams).page
+		if page > 1 {
+			<-ctx.Done()
+			cancelled <- page
+			return nil, Pagination{}, ctx.Err()
+		}
+		return []int{1, 2}, Pagination{PageNumber: 1, PageCount: pageCount, HasMoreItems: true}, nil
+	}
+}
+
+// waitCancelled waits for n fetches to be cancelled
+func waitCancelled(t *testing.T, cancelled <-chan int, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		select {
+		case <-cancelled:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("%d of the %d prefetched pages were cancelled", i, n)
+		}
+	}
+}
+
+func TestIteratorPrefetch(t *testing.T) {
+	var inflight, maxInflight int32
+	pages := attendeePages(10, false)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inflight, 1)
+		defer atomic.AddInt32(&inflight, -1)
+		for m := atomic.LoadInt32(&maxInflight); n > m && !atomic.CompareAndSwapInt32(&maxInflight, m, n); {
+			m = atomic.LoadInt32(&maxInflight)
+		}
+		// the later pages answer first
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		time.Sleep(time.Duration(10-page) * time.Millisecond)
+		pages(w, r)
+	})
+
+	all, err := c.UserEventAttendeesIterator(context.Background(), "me", &UserEventAttendeesRequest{}).Prefetch(3).All(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := orderIDs(all)
+	if len(ids) != 20 {
+		t.Fatalf("got %d attendees, want 20", len(ids))
+	}
+	for i, id := range ids {
+		if want := fmt.Sprintf("%d-a", i/2+1); i%2 == 0 && id != want {
+			t.Fatalf("attendee %d is %s, want %s", i, id, want)
+		}
+	}
+	if maxInflight > 3 {
+		t.Errorf("%d requests in flight, want at most 3", maxInflight)
+	}
+}
+
+func TestIteratorAllMaxCancelsPrefetch(t *testing.T) {
+	cancelled := make(chan int, 10)
+
+	it := newIterator(context.Background(), blockingPages(10, cancelled)).Prefetch(3)
+	all, err := it.All(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("got %d items, want 2", len(all))
+	}
+	waitCancelled(t, cancelled, 3)
+	if it.Next() {
+		t.Error("Next after All reached max")
+	}
+}
+
+func TestIteratorClose(t *testing.T) {
+	cancelled := make(chan int, 10)
+
+	it := newIterator(context.Background(), blockingPages(10, cancelled)).Prefetch(3)
+	if !it.Next() {
+		t.Fatal(it.Err())
+	}
+	it.Close()
+	it.Close()
+	waitCancelled(t, cancelled, 3)
+
+	if it.Next() {
+		t.Error("Next after Close")
+	}
+	if it.Value() != nil {
+		t.Error("value after Close")
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("got error %v after Close", err)
+	}
+}
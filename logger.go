@@ -0,0 +1,138 @@
+package eventbrite
+
+import (
+	"fmt"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+// Logger receives the structured logs of the client. Each message comes with alternating keys
+// and values, e.g. "method", "GET", "status", 200.
+type Logger interface {
+	Debug(msg string, keyvals ...interface{})
+	Info(msg string, keyvals ...interface{})
+	Error(msg string, keyvals ...interface{})
+}
+
+// WithLogger configures the client to log to l. Requests and responses are logged at the debug
+// level, retries at the info level. Tokens and personal data are scrubbed before reaching l.
+// Default is to not log.
+func WithLogger(l Logger) ClientOption {
+	return func(c *Client) error {
+		c.logger = newScrubLogger(l)
+		return nil
+	}
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+
+// NewSlogLogger returns a Logger writing to l
+func NewSlogLogger(l *slog.Logger) Logger {
+	return slogLogger{l}
+}
+
+type slogLogger struct {
+	l *slog.Logger
+}
+
+func (s slogLogger) Debug(msg string, keyvals ...interface{}) {
+	s.l.Log(context.Background(), slog.LevelDebug, msg, keyvals...)
+}
+
+func (s slogLogger) Info(msg string, keyvals ...interface{}) {
+	s.l.Log(context.Background(), slog.LevelInfo, msg, keyvals...)
+}
+
+func (s slogLogger) Error(msg string, keyvals ...interface{}) {
+	s.l.Log(context.Background(), slog.LevelError, msg, keyvals...)
+}
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys are the parts of log keys and query parameters whose values are never logged
+var sensitiveKeys = []string{
+	"token", "secret", "password", "authorization", "code",
+	"email", "first_name", "last_name", "phone", "address",
+}
+
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+func sensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// scrubLogger redacts the values of sensitive keys, sensitive query parameters and email
+// addresses before passing the logs on
+type scrubLogger struct {
+	l Logger
+}
+
+// newScrubLogger returns a scrubLogger passing the logs on to l, or discarding them if l is nil
+func newScrubLogger(l Logger) Logger {
+	if l == nil {
+		l = nopLogger{}
+	}
+	return scrubLogger{l}
+}
+
+func (s scrubLogger) Debug(msg string, keyvals ...interface{}) {
+	s.l.Debug(msg, scrub(keyvals)...)
+}
+
+func (s scrubLogger) Info(msg string, keyvals ...interface{}) {
+	s.l.Info(msg, scrub(keyvals)...)
+}
+
+func (s scrubLogger) Error(msg string, keyvals ...interface{}) {
+	s.l.Error(msg, scrub(keyvals)...)
+}
+
+func scrub(keyvals []interface{}) []interface{} {
+	out := make([]interface{}, len(keyvals))
+	for i, v := range keyvals {
+		if i%2 == 1 && sensitive(fmt.Sprint(keyvals[i-1])) {
+			out[i] = redacted
+			continue
+		}
+		switch v := v.(type) {
+		case url.Values:
+			out[i] = scrubQuery(v)
+		case string:
+			out[i] = emailPattern.ReplaceAllString(v, redacted)
+		case error:
+			out[i] = emailPattern.ReplaceAllString(v.Error(), redacted)
+		default:
+			out[i] = v
+		}
+	}
+	return out
+}
+
+// scrubQuery encodes the query with the values of sensitive parameters redacted
+func scrubQuery(q url.Values) string {
+	scrubbed := make(url.Values, len(q))
+	for k, vs := range q {
+		if sensitive(k) {
+			scrubbed[k] = []string{redacted}
+			continue
+		}
+		for _, v := range vs {
+			scrubbed.Add(k, emailPattern.ReplaceAllString(v, redacted))
+		}
+	}
+	return scrubbed.Encode()
+}
@@ -0,0 +1,98 @@
+package eventbrite
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestScrub(t *testing.T) {
+	tests := []struct {
+		name    string
+		keyvals []interface{}
+		want    []interface{}
+	}{
+		{"plain", []interface{}{"method", "GET", "status", 200}, []interface{}{"method", "GET", "status", 200}},
+		{"sensitive keys", []interface{}{"token", "abc", "client_secret", "def", "Authorization", "Bearer x", "user_email", 1},
+			[]interface{}{"token", redacted, "client_secret", redacted, "Authorization", redacted, "user_email", redacted}},
+		{"email in a string", []interface{}{"path", "/users/bob@example.com/"}, []interface{}{"path", "/users/[REDACTED]/"}},
+		{"email in an error", []interface{}{"error", errors.New("no user bob@example.com")},
+			[]interface{}{"error", "no user [REDACTED]"}},
+		{"query", []interface{}{"query", url.Values{"q": {"go"}, "token": {"abc"}, "name": {"bob@example.com"}}},
+			[]interface{}{"query", "name=%5BREDACTED%5D&q=go&token=%5BREDACTED%5D"}},
+		{"odd number", []interface{}{"status", 200, "token"}, []interface{}{"status", 200, "token"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scrub(tt.keyvals); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got  %v\nwant %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewSlogLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})))
+
+	l.Debug("debug message", "status", 200)
+	l.Info("info message", "operation", "EventGet")
+	l.Error("error message", "error", "failed")
+
+	want := `level=DEBUG msg="debug message" status=200
+level=INFO msg="info message" operation=EventGet
+level=ERROR msg="error message" error=failed
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewSlogLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "1"}`))
+	}, WithLogger(l))
+
+	req := url.Values{"email": {"bob@example.com"}, "q": {"from alice@example.com"}}
+	if err := c.getJSON(context.Background(), "/users/me/", req, new(User)); err != nil {
+		t.Fatal(err)
+	}
+
+	logs := buf.String()
+	if !strings.Contains(logs, "level=DEBUG") || !strings.Contains(logs, "/users/me/") {
+		t.Errorf("requests not logged:\n%s", logs)
+	}
+	for _, secret := range []string{"test-token", "bob@example.com", "alice@example.com"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("logs contain %q:\n%s", secret, logs)
+		}
+	}
+}
+
+func TestWithLoggerNil(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}, WithLogger(nil))
+
+	if _, err := c.Categories(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -7,6 +7,8 @@ import (
 
 // https://www.eventbrite.com/developer/v3/resources/uploads/
 type Media struct {
+	RawFields
+
 	// the method (always POST)
 	Method string `json:"upload_method"`
 	// oauth token
@@ -40,13 +42,13 @@ type MediaCreateUpload struct {
 	// The upload_token from the GET portion of the upload
 	UploadToken string `json:"upload_token" validate:"required"`
 	// X coordinate for top-left corner of crop mask
-	TopLeftX int `json:"crop_mask.top_left.x"`
+	TopLeftX int `json:"crop_mask.top_left.x,omitempty"`
 	// Y coordinate for top-left corner of crop mask
-	TopLeftY int `json:"crop_mask.top_left.y"`
+	TopLeftY int `json:"crop_mask.top_left.y,omitempty"`
 	// Crop mask width
-	Width int `json:"crop_mask.width"`
+	Width int `json:"crop_mask.width,omitempty"`
 	// Crop mask height
-	Height int `json:"crop_mask.height"`
+	Height int `json:"crop_mask.height,omitempty"`
 }
 
 // https://www.eventbrite.com/developer/v3/endpoints/media/#ebapi-get-media-upload
@@ -0,0 +1,73 @@
+package eventbrite
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Metrics receives the measurements of the client. Every measurement is labeled with the
+// logical operation, i.e. the name of the client method such as "EventSearch", so the IDs in
+// the request paths never end up in labels. See the prommetrics package for an implementation.
+type Metrics interface {
+	// ObserveRequest records an attempt to send a request. The status is 0 when no response
+	// was received.
+	ObserveRequest(operation, method string, status int, latency time.Duration)
+	// ObserveError records a failed call with the error key of the API, e.g. "NOT_FOUND",
+	// or a client side code such as "CANCELED"
+	ObserveError(operation, code string)
+	// ObserveRetry records a request sent again after a failed attempt
+	ObserveRetry(operation string)
+	// ObserveRateLimitWait records the time a request waited in the queue and on the rate limiter
+	ObserveRateLimitWait(operation string, wait time.Duration)
+}
+
+// WithMetrics configures the client to record its metrics to m
+func WithMetrics(m Metrics) ClientOption {
+	return func(c *Client) error {
+		if m == nil {
+			m = nopMetrics{}
+		}
+		c.metrics = m
+		return nil
+	}
+}
+
+type nopMetrics struct{}
+
+func (nopMetrics) ObserveRequest(string, string, int, time.Duration) {}
+func (nopMetrics) ObserveError(string, string)                       {}
+func (nopMetrics) ObserveRetry(string)                               {}
+func (nopMetrics) ObserveRateLimitWait(string, time.Duration)        {}
+
+// observeError records a failed call in the metrics and on its span, and returns err
+func (c *Client) observeError(ctx context.Context, err error) error {
+	if err != nil {
+		c.metrics.ObserveError(OperationName(ctx), errorCode(err))
+		traceError(ctx, err)
+	}
+	return err
+}
+
+// errorCode returns the code errors are counted by
+func errorCode(err error) string {
+	var apiErr Error
+	switch {
+	case errors.As(err, &apiErr) && apiErr.Err != "":
+		return apiErr.Err
+	case apiErr.Status != 0:
+		return "HTTP_" + strconv.Itoa(apiErr.Status)
+	case errors.Is(err, ErrClientClosed):
+		return "CLIENT_CLOSED"
+	case errors.Is(err, ErrOperationNotPermitted):
+		return "NOT_PERMITTED"
+	case errors.Is(err, context.Canceled):
+		return "CANCELED"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "DEADLINE_EXCEEDED"
+	default:
+		return "CLIENT_ERROR"
+	}
+}
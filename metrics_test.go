@@ -0,0 +1,131 @@
+package eventbrite
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// recordMetrics keeps the measurements it receives, without the durations
+type recordMetrics struct {
+	mu           sync.Mutex
+	measurements []string
+}
+
+func (m *recordMetrics) add(format string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.measurements = append(m.measurements, fmt.Sprintf(format, args...))
+}
+
+func (m *recordMetrics) ObserveRequest(operation, method string, status int, latency time.Duration) {
+	m.add("request %s %s %d", operation, method, status)
+}
+
+func (m *recordMetrics) ObserveError(operation, code string) {
+	m.add("error %s %s", operation, code)
+}
+
+func (m *recordMetrics) ObserveRetry(operation string) {
+	m.add("retry %s", operation)
+}
+
+func (m *recordMetrics) ObserveRateLimitWait(operation string, wait time.Duration) {
+	m.add("wait %s", operation)
+}
+
+func TestMetrics(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ClientOption
+		call func(c *Client) error
+		want []string
+	}{
+		{"success", nil, func(c *Client) error {
+			_, err := c.EventGet(context.Background(), "7")
+			return err
+		}, []string{"wait EventGet", "request EventGet GET 200"}},
+		{"api error", nil, func(c *Client) error {
+			_, err := c.EventGet(context.Background(), "404")
+			return err
+		}, []string{"wait EventGet", "request EventGet GET 404", "error EventGet NOT_FOUND"}},
+		{"retry", []ClientOption{WithRetryPolicy(RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond})},
+			func(c *Client) error {
+				_, err := c.EventGet(context.Background(), "503")
+				return err
+			}, []string{"wait EventGet", "request EventGet GET 503", "retry EventGet", "wait EventGet",
+				"request EventGet GET 503", "error EventGet HTTP_503"}},
+		{"not permitted", []ClientOption{WithReadOnly()}, func(c *Client) error {
+			_, err := c.EventDelete(context.Background(), "7")
+			return err
+		}, []string{"error EventDelete NOT_PERMITTED"}},
+		{"iterator", nil, func(c *Client) error {
+			_, err := c.EventSearchIterator(context.Background(), nil).All(0)
+			return err
+		}, []string{"wait EventSearch", "request EventSearch GET 200"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &recordMetrics{}
+			opts := append([]ClientOption{WithMetrics(m), WithRetryPolicy(RetryPolicy{})}, tt.opts...)
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.Contains(r.URL.Path, "/404"):
+					w.WriteHeader(http.StatusNotFound)
+					w.Write([]byte(`{"error": "NOT_FOUND", "status_code": 404}`))
+				case strings.Contains(r.URL.Path, "/503"):
+					w.WriteHeader(http.StatusServiceUnavailable)
+				default:
+					w.Write([]byte(`{}`))
+				}
+			}, opts...)
+
+			tt.call(c)
+			if got := strings.Join(m.measurements, ", "); got != strings.Join(tt.want, ", ") {
+				t.Errorf("got  %s\nwant %s", got, strings.Join(tt.want, ", "))
+			}
+		})
+	}
+}
+
+func TestWithMetricsNil(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}, WithMetrics(nil))
+
+	if _, err := c.Categories(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{Error{Err: "VENUE_AND_ONLINE", Status: 400}, "VENUE_AND_ONLINE"},
+		{fmt.Errorf("wrapped: %w", Error{Err: "NOT_FOUND", Status: 404}), "NOT_FOUND"},
+		{Error{Status: 502}, "HTTP_502"},
+		{ErrClientClosed, "CLIENT_CLOSED"},
+		{OperationNotPermittedError{Operation: "EventDelete"}, "NOT_PERMITTED"},
+		{cancelled.Err(), "CANCELED"},
+		{context.DeadlineExceeded, "DEADLINE_EXCEEDED"},
+		{errors.New("invalid request"), "CLIENT_ERROR"},
+	}
+
+	for _, tt := range tests {
+		if got := errorCode(tt.err); got != tt.want {
+			t.Errorf("errorCode(%v) = %s, want %s", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package eventbrite
+
+import (
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+)
+
+// Doer sends a request to the Eventbrite API
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to use a function as a Doer
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req)
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer sending the requests of the client. It may change the request,
+// observe the response, or answer without calling next at all.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware adds middlewares to the client. The first middleware added is the outermost:
+// it sees the request first and the response last. Middlewares run for every attempt of a
+// request, after the rate limiter let it through, with the request context carrying the
+// OperationName.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *Client) error {
+		c.middlewares = append(c.middlewares, mw...)
+		return nil
+	}
+}
+
+// chain builds the Doer sending the requests through the middlewares
+func (c *Client) chain() Doer {
+	var d Doer = DoerFunc(func(req *http.Request) (*http.Response, error) {
+		return ctxhttp.Do(req.Context(), c.httpClient, req)
+	})
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		d = c.middlewares[i](d)
+	}
+	return d
+}
+
+type operationKey struct{}
+
+// OperationName returns the name of the client method that made the request of the context,
+// e.g. "EventGet". It is meant for middlewares, which get the context from the request.
+func OperationName(ctx context.Context) string {
+	name, _ := ctx.Value(operationKey{}).(string)
+	return name
+}
+
+// clientMethodPrefix is the prefix of the runtime names of the methods of Client
+var clientMethodPrefix = reflect.TypeOf(Client{}).PkgPath() + ".(*Client)."
+
+// withOperation stores the name of the exported client method being called in the context
+func withOperation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, operationKey{}, callerOperation())
+}
+
+// callerOperation walks up the stack to the innermost exported method of Client. Iterators
+// are reported as the method fetching their pages.
+func callerOperation() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if strings.HasPrefix(frame.Function, clientMethodPrefix) {
+			name := strings.TrimPrefix(frame.Function, clientMethodPrefix)
+			if !strings.Contains(name, ".") && unicode.IsUpper([]rune(name)[0]) {
+				return name
+			}
+		}
+		if !more {
+			return ""
+		}
+	}
+}
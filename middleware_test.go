@@ -0,0 +1,126 @@
+package eventbrite
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// tracingMiddleware appends to trace when a request enters and when its response leaves
+func tracingMiddleware(name string, trace *[]string) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			*trace = append(*trace, ">"+name+" "+OperationName(req.Context()))
+			resp, err := next.Do(req)
+			*trace = append(*trace, "<"+name)
+			return resp, err
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(c *Client) error
+		want string
+	}{
+		{"operation", func(c *Client) error {
+			_, err := c.EventGet(context.Background(), "7")
+			return err
+		}, ">a EventGet >b EventGet >c EventGet <c <b <a"},
+		{"iterator", func(c *Client) error {
+			_, err := c.EventSearchIterator(context.Background(), nil).All(0)
+			return err
+		}, ">a EventSearch >b EventSearch >c EventSearch <c <b <a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var trace []string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{}`))
+			}, WithMiddleware(tracingMiddleware("a", &trace), tracingMiddleware("b", &trace)),
+				WithMiddleware(tracingMiddleware("c", &trace)))
+
+			if err := tt.call(c); err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.Join(trace, " "); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMiddlewareChangesRequest(t *testing.T) {
+	var got string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("X-Request-Id")
+		w.Write([]byte(`{}`))
+	}, WithMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Request-Id", "42")
+			return next.Do(req)
+		})
+	}))
+
+	if _, err := c.Categories(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got != "42" {
+		t.Errorf("got X-Request-Id %q, want the one set by the middleware", got)
+	}
+}
+
+func TestMiddlewareAnswers(t *testing.T) {
+	var sent int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&sent, 1)
+	}, WithMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"name": "Hall"}`)),
+				Request:    req,
+			}, nil
+		})
+	}))
+
+	venue, err := c.VenueGet(context.Background(), "7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if venue.Name != "Hall" || sent != 0 {
+		t.Errorf("got venue %q after %d requests, want the answer of the middleware", venue.Name, sent)
+	}
+}
+
+func TestMiddlewareRunsPerAttempt(t *testing.T) {
+	var attempts, seen int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}),
+		WithMiddleware(func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				atomic.AddInt32(&seen, 1)
+				return next.Do(req)
+			})
+		}))
+
+	if _, err := c.Categories(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 2 || seen != 2 {
+		t.Errorf("got %d attempts seen %d times by the middleware, want 2 and 2", attempts, seen)
+	}
+}
@@ -4,6 +4,8 @@ import "golang.org/x/net/context"
 
 // NotificationsResult is the response structure fornotifications
 type NotificationsResult struct {
+	RawFields
+
 	Notifications []Notification
 	Pagination    Pagination
 }
@@ -12,6 +14,8 @@ type NotificationsResult struct {
 //
 // see @https://www.eventbrite.com/developer/v3/response_formats/notification/#ebapi-std:format-notification
 type Notification struct {
+	RawFields
+
 	// Notification ID
 	ID string `json:"notification_id"`
 	// The title of the notification
@@ -37,5 +41,13 @@ type Notification struct {
 func (c *Client) Notifications(ctx context.Context) (*NotificationsResult, error) {
 	res := new(NotificationsResult)
 
-	return res, c.getJSON(ctx, "/notificaitons", nil, res)
+	return res, c.getJSON(ctx, "/users/me/notifications/", nil, res)
+}
+
+// NotificationsIterator returns an iterator walking all the pages of Notifications
+func (c *Client) NotificationsIterator(ctx context.Context) *Iterator[Notification] {
+	return newIterator(ctx, func(ctx context.Context) ([]Notification, Pagination, error) {
+		r, err := c.Notifications(ctx)
+		return r.Notifications, r.Pagination, err
+	})
 }
@@ -0,0 +1,93 @@
+package oauth
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/apzuk/go-eventbrite"
+
+	"golang.org/x/oauth2"
+)
+
+// ClientFunc receives the client built for the organizer who granted access. It typically
+// stores the token and redirects to the application.
+type ClientFunc func(w http.ResponseWriter, r *http.Request, client *eventbrite.Client, token *oauth2.Token)
+
+// ErrorFunc handles a failed callback
+type ErrorFunc func(w http.ResponseWriter, r *http.Request, err error)
+
+// RedirectHandler returns the handler sending the organizer to the consent screen. It stores a
+// new state in the StateCookie cookie, checked by the CallbackHandler.
+func (c *Config) RedirectHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state, err := NewState()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     StateCookie,
+			Value:    state,
+			Path:     "/",
+			MaxAge:   int(stateTTL.Seconds()),
+			HttpOnly: true,
+			Secure:   r.TLS != nil,
+			SameSite: http.SameSiteLaxMode,
+		})
+		http.Redirect(w, r, c.AuthCodeURL(state), http.StatusFound)
+	})
+}
+
+// CallbackHandler returns the handler serving the redirect URL. It validates the state, exchanges
+// the code for a token and hands a ready client to onClient. Failures are passed to onError, or
+// answered with a plain error response when onError is nil.
+func (c *Config) CallbackHandler(onClient ClientFunc, onError ErrorFunc) http.Handler {
+	if onError == nil {
+		onError = defaultError
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the state is single use
+		http.SetCookie(w, &http.Cookie{Name: StateCookie, Path: "/", MaxAge: -1})
+
+		q := r.URL.Query()
+		cookie, err := r.Cookie(StateCookie)
+		if err != nil || !ValidState(cookie.Value, q.Get("state")) {
+			onError(w, r, ErrInvalidState)
+			return
+		}
+
+		if code := q.Get("error"); code != "" {
+			onError(w, r, &CallbackError{Code: code, Description: q.Get("error_description")})
+			return
+		}
+		code := q.Get("code")
+		if code == "" {
+			onError(w, r, ErrMissingCode)
+			return
+		}
+
+		token, err := c.Exchange(r.Context(), code)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+		client, err := c.NewClient(token)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+
+		onClient(w, r, client, token)
+	})
+}
+
+func defaultError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusBadGateway
+	var cbErr *CallbackError
+	if errors.Is(err, ErrInvalidState) || errors.Is(err, ErrMissingCode) || errors.As(err, &cbErr) {
+		status = http.StatusBadRequest
+	}
+	http.Error(w, err.Error(), status)
+}
@@ -0,0 +1,125 @@
+// Package oauth implements the OAuth 2.0 authorization code flow organizers go through to grant
+// an application access to their Eventbrite account.
+//
+// https://www.eventbrite.com/platform/docs/authentication
+package oauth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/apzuk/go-eventbrite"
+
+	"golang.org/x/net/context"
+	"golang.org/x/oauth2"
+)
+
+// Endpoint is the OAuth 2.0 endpoint of Eventbrite
+var Endpoint = oauth2.Endpoint{
+	AuthURL:   "https://www.eventbrite.com/oauth/authorize",
+	TokenURL:  "https://www.eventbrite.com/oauth/token",
+	AuthStyle: oauth2.AuthStyleInParams,
+}
+
+const (
+	// StateCookie is the name of the cookie holding the state between the redirect and the callback
+	StateCookie = "eventbrite_oauth_state"
+	// stateTTL is how long an organizer has to grant access
+	stateTTL = 10 * time.Minute
+)
+
+var (
+	// ErrInvalidState is returned when the state of the callback does not match the one of
+	// the redirect, i.e. the callback was not initiated by the same browser
+	ErrInvalidState = errors.New("oauth: invalid state")
+	// ErrMissingCode is returned when the callback carries neither a code nor an error
+	ErrMissingCode = errors.New("oauth: missing authorization code")
+)
+
+// CallbackError is the error Eventbrite redirects back with, e.g. when the organizer denied access
+type CallbackError struct {
+	Code        string
+	Description string
+}
+
+func (e *CallbackError) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("oauth: %s: %s", e.Code, e.Description)
+	}
+	return "oauth: " + e.Code
+}
+
+// Config describes the application registered on Eventbrite
+type Config struct {
+	// The API key of the application
+	ClientID string
+	// The client secret of the application
+	ClientSecret string
+	// The URL Eventbrite redirects to once access was granted. It must match the OAuth redirect
+	// URI registered for the application.
+	RedirectURL string
+	// The OAuth endpoint. Defaults to Endpoint
+	Endpoint oauth2.Endpoint
+	// The client used to exchange codes. Defaults to http.DefaultClient
+	HTTPClient *http.Client
+	// Options applied to the clients built by NewClient, on top of the token
+	ClientOptions []eventbrite.ClientOption
+}
+
+func (c *Config) config() *oauth2.Config {
+	endpoint := c.Endpoint
+	if endpoint.AuthURL == "" && endpoint.TokenURL == "" {
+		endpoint = Endpoint
+	}
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		RedirectURL:  c.RedirectURL,
+		Endpoint:     endpoint,
+	}
+}
+
+func (c *Config) context(ctx context.Context) context.Context {
+	if c.HTTPClient != nil {
+		return context.WithValue(ctx, oauth2.HTTPClient, c.HTTPClient)
+	}
+	return ctx
+}
+
+// AuthCodeURL returns the URL of the consent screen to send the organizer to. The state is
+// passed back to the callback unchanged and must be checked against the one sent.
+func (c *Config) AuthCodeURL(state string) string {
+	return c.config().AuthCodeURL(state)
+}
+
+// Exchange trades the authorization code of the callback for a token
+func (c *Config) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	return c.config().Exchange(c.context(ctx), code)
+}
+
+// NewClient returns a client acting on behalf of the organizer who granted the token. The token
+// is refreshed through the token endpoint once it expires.
+func (c *Config) NewClient(token *oauth2.Token) (*eventbrite.Client, error) {
+	ts := c.config().TokenSource(c.context(context.Background()), token)
+	opts := append([]eventbrite.ClientOption{eventbrite.WithTokenSource(ts)}, c.ClientOptions...)
+	return eventbrite.NewClient(opts...)
+}
+
+// NewState returns a random state to protect the callback against cross-site request forgery
+func NewState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// ValidState reports whether the state of the callback matches the expected one
+func ValidState(expected, actual string) bool {
+	return expected != "" && subtle.ConstantTimeCompare([]byte(expected), []byte(actual)) == 1
+}
@@ -0,0 +1,274 @@
+package oauth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/apzuk/go-eventbrite"
+
+	"golang.org/x/net/context"
+	"golang.org/x/oauth2"
+)
+
+func TestNewState(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		state, err := NewState()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(state) != 43 {
+			t.Errorf("got a state of %d characters, want 43", len(state))
+		}
+		if url.QueryEscape(state) != state {
+			t.Errorf("state %q is not URL safe", state)
+		}
+		if seen[state] {
+			t.Fatalf("state %q returned twice", state)
+		}
+		seen[state] = true
+	}
+}
+
+func TestValidState(t *testing.T) {
+	tests := []struct {
+		expected, actual string
+		want             bool
+	}{
+		{"abc", "abc", true},
+		{"abc", "abd", false},
+		{"abc", "ab", false},
+		{"abc", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		if got := ValidState(tt.expected, tt.actual); got != tt.want {
+			t.Errorf("ValidState(%q, %q) = %t, want %t", tt.expected, tt.actual, got, tt.want)
+		}
+	}
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	c := &Config{ClientID: "key", ClientSecret: "secret", RedirectURL: "https://app.example.com/callback"}
+
+	u, err := url.Parse(c.AuthCodeURL("the-state"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.Scheme + "://" + u.Host + u.Path; got != Endpoint.AuthURL {
+		t.Errorf("got endpoint %s, want %s", got, Endpoint.AuthURL)
+	}
+
+	q := u.Query()
+	want := map[string]string{
+		"client_id":     "key",
+		"redirect_uri":  "https://app.example.com/callback",
+		"response_type": "code",
+		"state":         "the-state",
+		"client_secret": "",
+	}
+	for k, v := range want {
+		if got := q.Get(k); got != v {
+			t.Errorf("got %s %q, want %q", k, got, v)
+		}
+	}
+}
+
+// tokenServer is a stand-in token endpoint granting token for the code "the-code"
+func tokenServer(t *testing.T, token string) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if r.Form.Get("client_id") != "key" || r.Form.Get("client_secret") != "secret" {
+			http.Error(w, `{"error": "invalid_client"}`, http.StatusUnauthorized)
+			return
+		}
+		if r.Form.Get("grant_type") != "authorization_code" || r.Form.Get("code") != "the-code" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "invalid_grant"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "` + token + `", "token_type": "bearer"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func testConfig(tokenURL string) *Config {
+	return &Config{
+		ClientID:     "key",
+		ClientSecret: "secret",
+		RedirectURL:  "https://app.example.com/callback",
+		Endpoint:     oauth2.Endpoint{AuthURL: "https://eventbrite.example.com/authorize", TokenURL: tokenURL, AuthStyle: oauth2.AuthStyleInParams},
+	}
+}
+
+func TestExchange(t *testing.T) {
+	c := testConfig(tokenServer(t, "organizer-token").URL)
+
+	token, err := c.Exchange(context.Background(), "the-code")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "organizer-token" {
+		t.Errorf("got token %q, want %q", token.AccessToken, "organizer-token")
+	}
+
+	if _, err := c.Exchange(context.Background(), "another-code"); err == nil {
+		t.Error("no error for an invalid code")
+	}
+}
+
+func TestCallbackHandler(t *testing.T) {
+	tests := []struct {
+		name    string
+		cookie  string
+		query   string
+		wantErr error
+	}{
+		{"granted", "the-state", "state=the-state&code=the-code", nil},
+		{"no cookie", "", "state=the-state&code=the-code", ErrInvalidState},
+		{"state mismatch", "the-state", "state=another-state&code=the-code", ErrInvalidState},
+		{"no state", "the-state", "code=the-code", ErrInvalidState},
+		{"denied", "the-state", "state=the-state&error=access_denied&error_description=denied",
+			&CallbackError{Code: "access_denied", Description: "denied"}},
+		{"no code", "the-state", "state=the-state", ErrMissingCode},
+	}
+
+	c := testConfig(tokenServer(t, "organizer-token").URL)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotToken string
+			var gotErr error
+			h := c.CallbackHandler(func(w http.ResponseWriter, r *http.Request, client *eventbrite.Client, token *oauth2.Token) {
+				if client == nil {
+					t.Error("nil client")
+				}
+				gotToken = token.AccessToken
+			}, func(w http.ResponseWriter, r *http.Request, err error) {
+				gotErr = err
+			})
+
+			r := httptest.NewRequest(http.MethodGet, "/callback?"+tt.query, nil)
+			if tt.cookie != "" {
+				r.AddCookie(&http.Cookie{Name: StateCookie, Value: tt.cookie})
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			var cbErr *CallbackError
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if gotErr != nil || gotToken != "organizer-token" {
+					t.Errorf("got token %q and error %v, want the organizer token", gotToken, gotErr)
+				}
+			case *CallbackError:
+				if !errors.As(gotErr, &cbErr) || *cbErr != *want {
+					t.Errorf("got error %v, want %v", gotErr, want)
+				}
+			default:
+				if !errors.Is(gotErr, want) {
+					t.Errorf("got error %v, want %v", gotErr, want)
+				}
+			}
+
+			// the state cookie is cleared whatever the outcome
+			cleared := false
+			for _, cookie := range w.Result().Cookies() {
+				cleared = cleared || (cookie.Name == StateCookie && cookie.MaxAge < 0)
+			}
+			if !cleared {
+				t.Error("state cookie not cleared")
+			}
+		})
+	}
+}
+
+func TestCallbackHandlerDefaultError(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{"invalid state", "state=another-state&code=the-code", http.StatusBadRequest},
+		{"denied", "state=the-state&error=access_denied", http.StatusBadRequest},
+		{"exchange failed", "state=the-state&code=another-code", http.StatusBadGateway},
+	}
+
+	c := testConfig(tokenServer(t, "organizer-token").URL)
+	h := c.CallbackHandler(func(w http.ResponseWriter, r *http.Request, client *eventbrite.Client, token *oauth2.Token) {
+		t.Error("callback succeeded")
+	}, nil)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/callback?"+tt.query, nil)
+			r.AddCookie(&http.Cookie{Name: StateCookie, Value: "the-state"})
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if w.Code != tt.want {
+				t.Errorf("got status %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
+
+// TestFlow walks an organizer through the redirect, a stand-in consent screen and the callback,
+// then uses the client it produced against a stand-in API
+func TestFlow(t *testing.T) {
+	var gotAuth string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Write([]byte(`{"id": "1"}`))
+	}))
+	defer api.Close()
+
+	c := testConfig(tokenServer(t, "organizer-token").URL)
+	c.ClientOptions = []eventbrite.ClientOption{eventbrite.WithBaseURL(api.URL)}
+
+	var app *httptest.Server
+	mux := http.NewServeMux()
+	mux.Handle("/login", c.RedirectHandler())
+	mux.Handle("/callback", c.CallbackHandler(func(w http.ResponseWriter, r *http.Request, client *eventbrite.Client, token *oauth2.Token) {
+		defer client.Close()
+		if _, err := client.User(r.Context(), "me"); err != nil {
+			t.Error(err)
+		}
+	}, nil))
+	// the consent screen grants access right away
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, app.URL+"/callback?code=the-code&state="+url.QueryEscape(r.URL.Query().Get("state")), http.StatusFound)
+	})
+	app = httptest.NewServer(mux)
+	defer app.Close()
+
+	c.RedirectURL = app.URL + "/callback"
+	c.Endpoint.AuthURL = app.URL + "/authorize"
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := (&http.Client{Jar: jar}).Get(app.URL + "/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if gotAuth != "Bearer organizer-token" {
+		t.Errorf("got Authorization %q, want the organizer token", gotAuth)
+	}
+}
@@ -3,8 +3,6 @@ package eventbrite
 import (
 	"fmt"
 	"golang.org/x/net/context"
-	"strings"
-	"github.com/prometheus/common/log"
 )
 
 // Order is an object representing an order made against Eventbrite for one or more ticket classes
@@ -12,6 +10,8 @@ import (
 //
 // https://www.eventbrite.com/developer/v3/response_formats/order/#ebapi-std:format-order
 type Order struct {
+	RawFields
+
 	// When the attendee was created (order placed)
 	Created DateTime `json:"created"`
 	// When the attendee was last changed
@@ -27,11 +27,11 @@ type Order struct {
 	Email string `json:"email"`
 	// Cost breakdown for this order
 	Costs OrderCosts `json:"costs"`
-	// The event this order is against
+	// The event this order is against, populated with ExpandEvent
 	Event *Event `json:"event"`
-	// Refund request on this order
+	// Refund request on this order, populated with ExpandRefundRequests
 	RefundRequests *RefundRequest `json:"refund_requests"`
-	//Attendees on this order
+	// Attendees on this order, populated with ExpandAttendees
 	Attendees []Attendee `json:"attendees"`
 	// The event id this order is against
 	EventID string `json:"event_id"`
@@ -55,15 +55,12 @@ type OrderCosts struct {
 
 // OrderGet gets an order by ID an order object
 //
+// Valid expansions: event, attendees, refund_requests.
+//
 // https://www.eventbrite.com/developer/v3/endpoints/orders/#ebapi-orders
-func (c *Client) OrderGet(ctx context.Context, id string, exps ...string) (*Order, error) {
+func (c *Client) OrderGet(ctx context.Context, id string, expand ...Expansion) (*Order, error) {
 	o := new(Order)
 	path := fmt.Sprintf("/orders/%s/", id)
-	if len(exps) > 0 {
-		path = fmt.Sprintf("%s?expand=%s", path, strings.Join(exps, ","))
-	}
 
-	// todo : remove me
-	log.Info("path: ", path)
-	return o, c.getJSON(ctx, path, nil, o)
+	return o, c.getJSON(withExpansions(ctx, expand), path, nil, o)
 }
@@ -13,19 +13,19 @@ type CreateOrganizerRequest struct {
 	// The name of the organizer
 	Name string `json:"organizer.name" validate:"required"`
 	// The description of the organizer
-	Description string `json:"organizer.description.html"`
+	Description string `json:"organizer.description.html,omitempty"`
 	// The long description of the organizer
-	LongDescription string `json:"organizer.long_description.html"`
+	LongDescription string `json:"organizer.long_description.html,omitempty"`
 	// The logo id of the organizer
-	LogoID string `json:"organizer.logo.id"`
+	LogoID string `json:"organizer.logo.id,omitempty"`
 	// The website for the organizer
-	Website string `json:"organizer.website"`
+	Website string `json:"organizer.website,omitempty"`
 	// The Twitter handle for the organizer
-	Twitter string `json:"organizer.twitter"`
+	Twitter string `json:"organizer.twitter,omitempty"`
 	// The Facebook URL ID for the organizer
-	Facebook string `json:"organizer.facebook"`
+	Facebook string `json:"organizer.facebook,omitempty"`
 	// The Instagram numeric ID for the organizer
-	Instagram string `json:"organizer.instagram"`
+	Instagram string `json:"organizer.instagram,omitempty"`
 }
 
 // UpdateOrganizerRequest is the request structure for updating an organizer
@@ -33,21 +33,21 @@ type CreateOrganizerRequest struct {
 // https://www.eventbrite.co.uk/developer/v3/endpoints/organizers/#ebapi-id3
 type UpdateOrganizerRequest struct {
 	// The name of the organizer
-	Name string `json:"organizer.name"`
+	Name string `json:"organizer.name,omitempty"`
 	// The description of the organizer
-	Description string `json:"organizer.description.html"`
+	Description string `json:"organizer.description.html,omitempty"`
 	// The long description of the organizer
-	LongDescription string `json:"organizer.long_description.html"`
+	LongDescription string `json:"organizer.long_description.html,omitempty"`
 	// The logo id of the organizer
-	LogoId string `json:"organizer.logo.id"`
+	LogoId string `json:"organizer.logo.id,omitempty"`
 	// The website for the organizer
-	Website string `json:"organizer.website"`
+	Website string `json:"organizer.website,omitempty"`
 	// The Twitter handle for the organizer
-	Twitter string `json:"organizer.twitter"`
+	Twitter string `json:"organizer.twitter,omitempty"`
 	// The Facebook URL ID for the organizer
-	Facebook string `json:"organizer.facebook"`
+	Facebook string `json:"organizer.facebook,omitempty"`
 	// The Instagram numeric ID for the organizer
-	Instagram string `json:"organizer.instagram"`
+	Instagram string `json:"organizer.instagram,omitempty"`
 }
 
 // OrganizerEventsRequest is the request structure to get organizer events
@@ -56,21 +56,23 @@ type UpdateOrganizerRequest struct {
 type OrganizerEventsRequest struct {
 	// Only return events with a specific status set. This should be a comma delimited string of status.
 	// Valid status: all, draft, live, canceled, started, ended.
-	Status string `json:"status"`
+	Status string `json:"status,omitempty"`
 	// How to order the results (Valid choices are: start_asc, start_desc, created_asc, or created_desc)
-	OrderBy string `json:"order_by"`
+	OrderBy string `json:"order_by,omitempty"`
 	// Only return events with start dates after the given date
-	StartDateRangeStart string `json:"start_date.range_start"`
+	StartDateRangeStart string `json:"start_date.range_start,omitempty"`
 	// Only return events with start dates after the given date
-	StartDateRangeEnd string `json:"start_date.range_end"`
+	StartDateRangeEnd string `json:"start_date.range_end,omitempty"`
 	// Only show public events even if viewing your own events.
-	PublicOnly bool `json:"only_public"`
+	PublicOnly bool `json:"only_public,omitempty"`
 }
 
 // OrganizerEventsResult is the response structure for organizer events request
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/organizers/#ebapi-get-organizers-id-events
 type OrganizerEventsResult struct {
+	RawFields
+
 	Events     []Event    `json:"events"`
 	Pagination Pagination `json:"pagination"`
 }
@@ -90,7 +92,7 @@ func (c *Client) OrganizerCreate(ctx context.Context, req *CreateOrganizerReques
 func (c *Client) OrganizerGet(ctx context.Context, id string) (*Organizer, error) {
 	resp := new(Organizer)
 
-	return resp, c.postJSON(ctx, "/organizers/"+id, nil, resp)
+	return resp, c.getJSON(ctx, "/organizers/"+id, nil, resp)
 }
 
 // OrganizerCreate updates an organizer and returns it as as organizer.
@@ -104,9 +106,20 @@ func (c *Client) OrganizerUpdate(ctx context.Context, id string, req *UpdateOrga
 
 // OrganizerCreate gets events of the organizer.
 //
+// Valid expansions: venue, organizer, format, category, subcategory, ticket_classes,
+// ticket_availability, bookmark_info, refund_policy.
+//
 // https://www.eventbrite.com/developer/v3/endpoints/organizers/#ebapi-get-organizers-id-events
-func (c *Client) OrganizerGetEvents(ctx context.Context, id string, req *OrganizerEventsRequest) (*OrganizerEventsResult, error) {
+func (c *Client) OrganizerGetEvents(ctx context.Context, id string, req *OrganizerEventsRequest, expand ...Expansion) (*OrganizerEventsResult, error) {
 	resp := new(OrganizerEventsResult)
 
-	return resp, c.getJSON(ctx, fmt.Sprintf("/organizers/%s/events/", id), req, resp)
+	return resp, c.getJSON(withExpansions(ctx, expand), fmt.Sprintf("/organizers/%s/events/", id), req, resp)
+}
+
+// OrganizerGetEventsIterator returns an iterator walking all the pages of OrganizerGetEvents
+func (c *Client) OrganizerGetEventsIterator(ctx context.Context, id string, req *OrganizerEventsRequest, expand ...Expansion) *Iterator[Event] {
+	return newIterator(ctx, func(ctx context.Context) ([]Event, Pagination, error) {
+		r, err := c.OrganizerGetEvents(ctx, id, req, expand...)
+		return r.Events, r.Pagination, err
+	})
 }
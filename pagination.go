@@ -0,0 +1,183 @@
+package eventbrite
+
+import (
+	"net/url"
+	"strconv"
+	"sync"
+
+	"golang.org/x/net/context"
+)
+
+type pageKey struct{}
+
+type pageParams struct {
+	page         int
+	continuation string
+}
+
+// withPage returns a context whose GET request asks for the given page. The continuation
+// token takes precedence over the page number when set.
+func withPage(ctx context.Context, page int, continuation string) context.Context {
+	return context.WithValue(ctx, pageKey{}, pageParams{page: page, continuation: continuation})
+}
+
+// setPageParams adds the page requested through withPage to the query
+func setPageParams(ctx context.Context, q url.Values) {
+	p, ok := ctx.Value(pageKey{}).(pageParams)
+	if !ok {
+		return
+	}
+	if p.continuation != "" {
+		q.Set("continuation", p.continuation)
+	} else if p.page > 0 {
+		q.Set("page", strconv.Itoa(p.page))
+	}
+}
+
+// pageFetcher fetches the page requested by the context, returning its items and pagination
+type pageFetcher[T any] func(ctx context.Context) ([]T, Pagination, error)
+
+// pager walks the pages of a paginated listing. It is embedded by Iterator, which walks the
+// items of each page.
+type pager[T any] struct {
+	ctx          context.Context
+	fetch        pageFetcher[T]
+	page         int
+	continuation string
+	done         bool
+	err          error
+
+	// prefetching state, see setPrefetch
+	workers   int
+	pageCount int
+	scheduled int
+	pending   []chan pageResult[T]
+	cancel    context.CancelFunc
+	closeOnce sync.Once
+}
+
+type pageResult[T any] struct {
+	items []T
+	err   error
+}
+
+func newPager[T any](ctx context.Context, fetch pageFetcher[T]) pager[T] {
+	return pager[T]{ctx: ctx, fetch: fetch}
+}
+
+// setPrefetch makes the pager fetch up to workers pages concurrently once the page count is
+// known from the first page. It must be called before the first page is fetched.
+func (p *pager[T]) setPrefetch(workers int) {
+	if p.page == 0 {
+		p.workers = workers
+	}
+}
+
+// nextPage fetches the following page. It returns false once all pages were read or on error.
+func (p *pager[T]) nextPage() ([]T, bool) {
+	if p.done || p.err != nil {
+		return nil, false
+	}
+	if err := p.ctx.Err(); err != nil {
+		p.err = err
+		return nil, false
+	}
+	if p.pageCount > 0 {
+		return p.nextPrefetched()
+	}
+
+	items, pagination, err := p.fetch(withPage(p.ctx, p.page+1, p.continuation))
+	if err != nil {
+		p.err = err
+		return nil, false
+	}
+
+	p.page++
+	if pagination.PageNumber > 0 {
+		p.page = pagination.PageNumber
+	}
+	p.continuation = pagination.Continuation
+	if !pagination.HasMoreItems || (pagination.PageCount > 0 && p.page >= pagination.PageCount) {
+		p.done = true
+	}
+
+	// listings walked with a continuation token can only be read one page after the other
+	if p.workers > 1 && !p.done && p.continuation == "" && pagination.PageCount > 0 {
+		p.startPrefetch(pagination.PageCount)
+	}
+
+	return items, true
+}
+
+// startPrefetch switches the pager to fetching the remaining pages concurrently
+func (p *pager[T]) startPrefetch(pageCount int) {
+	p.pageCount = pageCount
+	p.scheduled = p.page
+	p.ctx, p.cancel = context.WithCancel(p.ctx)
+	p.schedule()
+}
+
+// schedule starts fetching pages until workers pages are in flight or waiting to be read.
+// Limiting the pages ahead of the reader bounds both the goroutines and the memory used.
+func (p *pager[T]) schedule() {
+	for len(p.pending) < p.workers && p.scheduled < p.pageCount {
+		p.scheduled++
+		res := make(chan pageResult[T], 1)
+		go func(ctx context.Context, page int) {
+			items, _, err := p.fetch(withPage(ctx, page, ""))
+			res <- pageResult[T]{items: items, err: err}
+		}(p.ctx, p.scheduled)
+		p.pending = append(p.pending, res)
+	}
+}
+
+// nextPrefetched returns the following page once its fetch completed, so pages are yielded
+// in order whatever order the requests finish in
+func (p *pager[T]) nextPrefetched() ([]T, bool) {
+	if len(p.pending) == 0 {
+		p.finish()
+		return nil, false
+	}
+
+	var res pageResult[T]
+	select {
+	case res = <-p.pending[0]:
+	case <-p.ctx.Done():
+		res.err = p.ctx.Err()
+	}
+	p.pending = p.pending[1:]
+
+	if res.err != nil {
+		p.err = res.err
+		p.finish()
+		return nil, false
+	}
+
+	p.page++
+	if p.page >= p.pageCount {
+		p.done = true
+		p.finish()
+	} else {
+		p.schedule()
+	}
+	return res.items, true
+}
+
+// finish cancels the requests still in flight
+func (p *pager[T]) finish() {
+	p.closeOnce.Do(func() {
+		if p.cancel != nil {
+			p.cancel()
+		}
+	})
+}
+
+// Err returns the error that stopped the iteration, if any
+func (p *pager[T]) Err() error {
+	return p.err
+}
+
+// limitReached reports whether a collect-all helper holding n items should stop
+func limitReached(n, max int) bool {
+	return max > 0 && n >= max
+}
@@ -0,0 +1,156 @@
+package eventbrite
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+// ErrOperationNotPermitted matches the errors returned for the calls denied by the policy of
+// the client. To access the details use errors.As with an OperationNotPermittedError value.
+var ErrOperationNotPermitted = errors.New("eventbrite: operation not permitted")
+
+// OperationNotPermittedError is returned for a call denied by the policy of the client, see
+// WithReadOnly, WithAllowedOperations and WithDeniedOperations. No request was sent.
+type OperationNotPermittedError struct {
+	// The name of the operation, e.g. "EventDelete"
+	Operation string
+	// The HTTP method of the request that was not sent
+	Method string
+	// The API path of the request that was not sent, relative to the base url
+	Path string
+	// Why the operation was denied
+	Reason string
+}
+
+func (e OperationNotPermittedError) Error() string {
+	return fmt.Sprintf("eventbrite: operation %s not permitted: %s", e.Operation, e.Reason)
+}
+
+// Is reports whether target is ErrOperationNotPermitted
+func (e OperationNotPermittedError) Is(target error) bool {
+	return target == ErrOperationNotPermitted
+}
+
+// policy decides which operations the client may call. The zero value permits them all.
+type policy struct {
+	readOnly bool
+	// nil permits every operation
+	allowed map[string]bool
+	denied  map[string]bool
+}
+
+func (p policy) enabled() bool {
+	return p.readOnly || p.allowed != nil || p.denied != nil
+}
+
+// check returns the reason the operation is denied, or an empty string if it is permitted. An
+// empty operation is denied, so a request whose operation could not be found out never slips
+// through a denylist.
+func (p policy) check(operation, method string) string {
+	switch {
+	case operation == "":
+		// a request not made by a method of Client cannot be checked against the lists
+		return "unknown operation"
+	case p.readOnly && method != http.MethodGet:
+		return "read-only client"
+	case p.allowed != nil && !p.allowed[operation]:
+		return "not in the allowed operations"
+	case p.denied[operation]:
+		return "in the denied operations"
+	}
+	return ""
+}
+
+// WithReadOnly configures the client to deny the operations changing data, i.e. sending POST
+// or DELETE requests, such as EventUpdate or UserDeleteContactList. They fail with an
+// OperationNotPermittedError before any request is sent.
+//
+// Every decision of the policy is logged to the Logger of the client: the permitted
+// operations at the info level and the denied ones at the error level.
+func WithReadOnly() ClientOption {
+	return func(c *Client) error {
+		c.policy.readOnly = true
+		return nil
+	}
+}
+
+// WithAllowedOperations configures the client to deny the operations not listed, by their
+// method name, e.g. "EventGet". The other calls fail with an OperationNotPermittedError before
+// any request is sent, and the decisions are logged as with WithReadOnly. An iterator is
+// permitted when the method fetching its pages is, e.g. EventSearch for EventSearchIterator.
+//
+// The names must be operations of Services: NewClient rejects the other ones, including the
+// names of the iterators.
+func WithAllowedOperations(operations ...string) ClientOption {
+	return func(c *Client) error {
+		if err := checkOperations(operations); err != nil {
+			return err
+		}
+		if c.policy.allowed == nil {
+			c.policy.allowed = map[string]bool{}
+		}
+		for _, op := range operations {
+			c.policy.allowed[op] = true
+		}
+		return nil
+	}
+}
+
+// WithDeniedOperations configures the client to deny the operations listed, by their method
+// name, e.g. "EventDelete". They fail with an OperationNotPermittedError before any request is
+// sent, and the decisions are logged as with WithReadOnly. Denying an operation denies its
+// iterator too.
+//
+// The names must be operations of Services: NewClient rejects the other ones, including the
+// names of the iterators.
+func WithDeniedOperations(operations ...string) ClientOption {
+	return func(c *Client) error {
+		if err := checkOperations(operations); err != nil {
+			return err
+		}
+		if c.policy.denied == nil {
+			c.policy.denied = map[string]bool{}
+		}
+		for _, op := range operations {
+			c.policy.denied[op] = true
+		}
+		return nil
+	}
+}
+
+// checkOperations returns an error if one of the names is not an operation of Services, so a
+// misspelled name does not silently deny, or permit, an operation
+func checkOperations(names []string) error {
+	for _, name := range names {
+		if operations[name] {
+			continue
+		}
+		if op := strings.TrimSuffix(name, "Iterator"); op != name && operations[op] {
+			return fmt.Errorf("eventbrite: unknown operation %q, the iterator is covered by %q", name, op)
+		}
+		return fmt.Errorf("eventbrite: unknown operation %q", name)
+	}
+	return nil
+}
+
+// permit applies the policy of the client to the operation of the context, and logs the decision
+func (c *Client) permit(ctx context.Context, method, path string) error {
+	if !c.policy.enabled() {
+		return nil
+	}
+
+	operation := OperationName(ctx)
+	reason := c.policy.check(operation, method)
+	if reason == "" {
+		c.logger.Info("eventbrite: operation permitted", "operation", operation, "method", method, "path", path)
+		return nil
+	}
+
+	c.logger.Error("eventbrite: operation not permitted", "operation", operation, "method", method, "path", path,
+		"reason", reason)
+	return OperationNotPermittedError{Operation: operation, Method: method, Path: path, Reason: reason}
+}
@@ -0,0 +1,141 @@
+package eventbrite_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/apzuk/go-eventbrite"
+)
+
+func TestPolicy(t *testing.T) {
+	var sent int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&sent, 1)
+		w.Write([]byte(`{"id": "7"}`))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	calls := map[string]func(c *eventbrite.Client) error{
+		"EventGet":    func(c *eventbrite.Client) error { _, err := c.EventGet(ctx, "7"); return err },
+		"EventDelete": func(c *eventbrite.Client) error { _, err := c.EventDelete(ctx, "7"); return err },
+		"Categories":  func(c *eventbrite.Client) error { _, err := c.Categories(ctx); return err },
+		"EventSearchIterator": func(c *eventbrite.Client) error {
+			_, err := c.EventSearchIterator(ctx, nil).All(0)
+			return err
+		},
+	}
+
+	tests := []struct {
+		name string
+		opts []eventbrite.ClientOption
+		call string
+		// the reason of the denial, empty if the call is permitted
+		reason string
+	}{
+		{"no policy", nil, "EventDelete", ""},
+		{"read-only get", []eventbrite.ClientOption{eventbrite.WithReadOnly()}, "EventGet", ""},
+		{"read-only delete", []eventbrite.ClientOption{eventbrite.WithReadOnly()}, "EventDelete", "read-only client"},
+		{"allowed", []eventbrite.ClientOption{eventbrite.WithAllowedOperations("EventGet", "EventSearch")}, "EventGet", ""},
+		{"not allowed", []eventbrite.ClientOption{eventbrite.WithAllowedOperations("EventGet", "EventSearch")}, "Categories",
+			"not in the allowed operations"},
+		{"allowed iterator", []eventbrite.ClientOption{eventbrite.WithAllowedOperations("EventSearch")}, "EventSearchIterator", ""},
+		{"denied", []eventbrite.ClientOption{eventbrite.WithDeniedOperations("EventDelete")}, "EventDelete", "in the denied operations"},
+		{"not denied", []eventbrite.ClientOption{eventbrite.WithDeniedOperations("EventDelete")}, "EventGet", ""},
+		{"denied iterator", []eventbrite.ClientOption{eventbrite.WithDeniedOperations("EventSearch")}, "EventSearchIterator",
+			"in the denied operations"},
+		{"allowed and denied", []eventbrite.ClientOption{eventbrite.WithAllowedOperations("EventGet"),
+			eventbrite.WithDeniedOperations("EventGet")}, "EventGet", "in the denied operations"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := recordLogger{}
+			opts := append([]eventbrite.ClientOption{eventbrite.WithBaseURL(srv.URL), eventbrite.WithToken("test-token"),
+				eventbrite.WithRateLimits(eventbrite.RateLimits{}), eventbrite.WithLogger(l)}, tt.opts...)
+			c, err := eventbrite.NewClient(opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer c.Close()
+			before := atomic.LoadInt32(&sent)
+
+			err = calls[tt.call](c)
+			sentRequest := atomic.LoadInt32(&sent) > before
+
+			if tt.reason == "" {
+				if err != nil || !sentRequest {
+					t.Errorf("got error %v, sent %t, want the request sent", err, sentRequest)
+				}
+				if len(tt.opts) > 0 && !logged(l["info"], "eventbrite: operation permitted") {
+					t.Errorf("permission not logged, got %q", l["info"])
+				}
+				return
+			}
+
+			var denied eventbrite.OperationNotPermittedError
+			if !errors.Is(err, eventbrite.ErrOperationNotPermitted) || !errors.As(err, &denied) {
+				t.Fatalf("got error %v, want an OperationNotPermittedError", err)
+			}
+			operation := strings.TrimSuffix(tt.call, "Iterator")
+			if denied.Operation != operation || denied.Reason != tt.reason || denied.Method == "" || denied.Path == "" {
+				t.Errorf("got %+v, want operation %s denied as %s", denied, operation, tt.reason)
+			}
+			if sentRequest {
+				t.Error("the request was sent")
+			}
+			if !logged(l["error"], "eventbrite: operation not permitted") {
+				t.Errorf("denial not logged, got %q", l["error"])
+			}
+		})
+	}
+}
+
+func logged(msgs []string, prefix string) bool {
+	for _, msg := range msgs {
+		if strings.HasPrefix(msg, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPolicyOperationNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr string
+	}{
+		{"EventGet", ""},
+		{"UserDeleteContactList", ""},
+		{"EventGot", `eventbrite: unknown operation "EventGot"`},
+		{"eventGet", `eventbrite: unknown operation "eventGet"`},
+		{"", `eventbrite: unknown operation ""`},
+		{"EventSearchIterator", `eventbrite: unknown operation "EventSearchIterator", the iterator is covered by "EventSearch"`},
+		{"Close", `eventbrite: unknown operation "Close"`},
+		{"RateBudget", `eventbrite: unknown operation "RateBudget"`},
+		{"InvalidateCache", `eventbrite: unknown operation "InvalidateCache"`},
+	}
+
+	options := map[string]func(...string) eventbrite.ClientOption{
+		"WithAllowedOperations": eventbrite.WithAllowedOperations,
+		"WithDeniedOperations":  eventbrite.WithDeniedOperations,
+	}
+	for option, with := range options {
+		for _, tt := range tests {
+			c, err := eventbrite.NewClient(eventbrite.WithToken("test-token"), with("EventGet", tt.name))
+			if err == nil {
+				c.Close()
+			}
+			if got := fmt.Sprint(err); (err != nil || tt.wantErr != "") && got != tt.wantErr {
+				t.Errorf("%s(%q): got error %s, want %s", option, tt.name, got, tt.wantErr)
+			}
+		}
+	}
+}
@@ -6,6 +6,8 @@ import "golang.org/x/net/context"
 //
 // https://www.eventbrite.com/developer/v3/response_formats/pricing/#ebapi-std:format-fee_rate
 type FeeRate struct {
+	RawFields
+
 	// The (ISO 3166 alpha-2 code of the) country
 	Country CountryCode `json:"country"`
 	// The (ISO 4217 3-character code of the) currency
@@ -45,23 +47,25 @@ type FeeRequest struct {
 	Currency CurrencyCode `json:"currency" validate:"required"`
 	// The assortment package name to get the price for. One of [‘any’, ‘package1’, ‘package2’].
 	// If it’s not provided, or the value is ‘any’, all the existing variants will be returned.’
-	Plan string `json:"plan"`
+	Plan string `json:"plan,omitempty"`
 	// The payment type to get the price for. One of [‘any’, ‘eventbrite’, ‘authnet’, ‘moneris’,
 	// ‘paypal’, ‘google’, ‘manual’, ‘free’, ‘offline’, ‘cash’, ‘check’, ‘invoice’]. If it’s not provided,
 	// or the value is ‘any’, all the existing variants will be returned.
-	PaymentType string `json:"payment_type"`
+	PaymentType string `json:"payment_type,omitempty"`
 	// The sales channel. One of [‘any’, ‘atd’, ‘web’]. If it’s not provided, or the value is ‘any’,
 	// all the existing variants will be returned.
-	Channel string `json:"channel"`
+	Channel string `json:"channel,omitempty"`
 	// The item type for which get the price fee rates. One of [‘any’, ‘ticket’, ‘product’]. If it’s not provided,
 	// or the value is ‘any’, all the existing variants will be returned.
-	ItemType string `json:"item_type"`
+	ItemType string `json:"item_type,omitempty"`
 }
 
 // FeeResponse is the response structure for fee rate request
 //
 // https://www.eventbrite.com/developer/v3/endpoints/pricing/#ebapi-get-pricing-fee-rates
 type FeeResponse struct {
+	RawFields
+
 	FeeRates []FeeRate `json:"fee_rates"`
 }
 
@@ -0,0 +1,110 @@
+// Package prommetrics records the metrics of an Eventbrite client with Prometheus.
+//
+//	m := prommetrics.New("myservice")
+//	prometheus.MustRegister(m)
+//	client, err := eventbrite.NewClient(eventbrite.WithToken(token), eventbrite.WithMetrics(m))
+package prommetrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/apzuk/go-eventbrite"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector implements eventbrite.Metrics. It is a prometheus.Collector to register with
+// a registry.
+type Collector struct {
+	requests *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+	retries  *prometheus.CounterVec
+	wait     *prometheus.HistogramVec
+}
+
+var _ eventbrite.Metrics = (*Collector)(nil)
+
+// New returns a Collector whose metrics are prefixed with the namespace, which may be empty
+func New(namespace string) *Collector {
+	const subsystem = "eventbrite_client"
+
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "requests_total",
+			Help:      "Requests sent to the Eventbrite API, including retries, by operation, method and status.",
+		}, []string{"operation", "method", "status"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the requests sent to the Eventbrite API by operation and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "method"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "errors_total",
+			Help:      "Failed calls by operation and error code.",
+		}, []string{"operation", "code"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "retries_total",
+			Help:      "Requests sent again after a failed attempt by operation.",
+		}, []string{"operation"}),
+		wait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "rate_limit_wait_seconds",
+			Help:      "Time requests waited in the queue and on the rate limiter by operation.",
+			Buckets:   []float64{.001, .01, .1, .5, 1, 5, 15, 30, 60, 300},
+		}, []string{"operation"}),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.latency.Describe(ch)
+	c.errors.Describe(ch)
+	c.retries.Describe(ch)
+	c.wait.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.latency.Collect(ch)
+	c.errors.Collect(ch)
+	c.retries.Collect(ch)
+	c.wait.Collect(ch)
+}
+
+// ObserveRequest implements eventbrite.Metrics
+func (c *Collector) ObserveRequest(operation, method string, status int, latency time.Duration) {
+	code := "error"
+	if status > 0 {
+		code = strconv.Itoa(status)
+	}
+	c.requests.WithLabelValues(operation, method, code).Inc()
+	c.latency.WithLabelValues(operation, method).Observe(latency.Seconds())
+}
+
+// ObserveError implements eventbrite.Metrics
+func (c *Collector) ObserveError(operation, code string) {
+	c.errors.WithLabelValues(operation, code).Inc()
+}
+
+// ObserveRetry implements eventbrite.Metrics
+func (c *Collector) ObserveRetry(operation string) {
+	c.retries.WithLabelValues(operation).Inc()
+}
+
+// ObserveRateLimitWait implements eventbrite.Metrics
+func (c *Collector) ObserveRateLimitWait(operation string, wait time.Duration) {
+	c.wait.WithLabelValues(operation).Observe(wait.Seconds())
+}
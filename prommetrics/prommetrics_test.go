@@ -0,0 +1,83 @@
+package prommetrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCollector(t *testing.T) {
+	c := New("myservice")
+	c.ObserveRequest("EventGet", "GET", 200, 50*time.Millisecond)
+	c.ObserveRequest("EventGet", "GET", 200, 150*time.Millisecond)
+	c.ObserveRequest("EventGet", "GET", 0, time.Second)
+	c.ObserveError("EventGet", "CANCELED")
+	c.ObserveRetry("EventUpdate")
+	c.ObserveRateLimitWait("EventGet", 2*time.Second)
+
+	tests := []struct {
+		name   string
+		metric prometheus.Collector
+		want   float64
+	}{
+		{"requests with a status", c.requests.WithLabelValues("EventGet", "GET", "200"), 2},
+		{"requests without response", c.requests.WithLabelValues("EventGet", "GET", "error"), 1},
+		{"errors", c.errors.WithLabelValues("EventGet", "CANCELED"), 1},
+		{"retries", c.retries.WithLabelValues("EventUpdate"), 1},
+	}
+	for _, tt := range tests {
+		if got := testutil.ToFloat64(tt.metric); got != tt.want {
+			t.Errorf("%s: got %g, want %g", tt.name, got, tt.want)
+		}
+	}
+
+	want := `
+# HELP myservice_eventbrite_client_rate_limit_wait_seconds Time requests waited in the queue and on the rate limiter by operation.
+# TYPE myservice_eventbrite_client_rate_limit_wait_seconds histogram
+myservice_eventbrite_client_rate_limit_wait_seconds_bucket{operation="EventGet",le="0.001"} 0
+myservice_eventbrite_client_rate_limit_wait_seconds_bucket{operation="EventGet",le="0.01"} 0
+myservice_eventbrite_client_rate_limit_wait_seconds_bucket{operation="EventGet",le="0.1"} 0
+myservice_eventbrite_client_rate_limit_wait_seconds_bucket{operation="EventGet",le="0.5"} 0
+myservice_eventbrite_client_rate_limit_wait_seconds_bucket{operation="EventGet",le="1"} 0
+myservice_eventbrite_client_rate_limit_wait_seconds_bucket{operation="EventGet",le="5"} 1
+myservice_eventbrite_client_rate_limit_wait_seconds_bucket{operation="EventGet",le="15"} 1
+myservice_eventbrite_client_rate_limit_wait_seconds_bucket{operation="EventGet",le="30"} 1
+myservice_eventbrite_client_rate_limit_wait_seconds_bucket{operation="EventGet",le="60"} 1
+myservice_eventbrite_client_rate_limit_wait_seconds_bucket{operation="EventGet",le="300"} 1
+myservice_eventbrite_client_rate_limit_wait_seconds_bucket{operation="EventGet",le="+Inf"} 1
+myservice_eventbrite_client_rate_limit_wait_seconds_sum{operation="EventGet"} 2
+myservice_eventbrite_client_rate_limit_wait_seconds_count{operation="EventGet"} 1
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(want), "myservice_eventbrite_client_rate_limit_wait_seconds"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCollectorRegistration(t *testing.T) {
+	reg := prometheus.NewPedanticRegistry()
+	c := New("")
+	if err := reg.Register(c); err != nil {
+		t.Fatal(err)
+	}
+	c.ObserveRequest("EventGet", "GET", 200, time.Millisecond)
+	c.ObserveError("EventGet", "NOT_FOUND")
+	c.ObserveRetry("EventGet")
+	c.ObserveRateLimitWait("EventGet", time.Millisecond)
+
+	if got, err := testutil.GatherAndCount(reg); err != nil || got != 5 {
+		t.Errorf("got %d metrics and error %v, want 5", got, err)
+	}
+	problems, err := testutil.GatherAndLint(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range problems {
+		t.Errorf("%s: %s", p.Metric, p.Text)
+	}
+	if err := reg.Register(New("")); err == nil {
+		t.Error("registered a second collector with the same metrics")
+	}
+}
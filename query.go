@@ -0,0 +1,179 @@
+package eventbrite
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	dateTimeType = reflect.TypeOf(DateTime{})
+	dateType     = reflect.TypeOf(Date{})
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// encodeQuery returns the query parameters of a GET request, named after the json tags of the
+// request struct, which may be passed by value or by pointer. A url.Values is used as is.
+//
+// Fields are sent unless they have no value, i.e. an empty string, a nil pointer or slice or an
+// unset date; with omitempty zero numbers and false are left out as well. Slices are sent as
+// comma separated lists, DateTime and time.Time values in UTC as 2006-01-02T15:04:05Z, and
+// nested structs with a dotted name, e.g. "location.address". The fields of embedded
+// structs are promoted.
+func encodeQuery(apiReq interface{}) url.Values {
+	values := url.Values{}
+	if q, ok := apiReq.(url.Values); ok {
+		for k, v := range q {
+			values[k] = append([]string(nil), v...)
+		}
+		return values
+	}
+
+	v := reflect.ValueOf(apiReq)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return values
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		encodeQueryFields(values, "", v)
+	}
+	return values
+}
+
+// isNilRequest reports whether apiReq is nil or a nil pointer, i.e. an optional request left out
+func isNilRequest(apiReq interface{}) bool {
+	if apiReq == nil {
+		return true
+	}
+	v := reflect.ValueOf(apiReq)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// encodeQueryFields adds the fields of the struct to values, prefixing their names
+func encodeQueryFields(values url.Values, prefix string, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		fv := v.Field(i)
+
+		if field.Anonymous && name == "" {
+			for fv.Kind() == reflect.Ptr && !fv.IsNil() {
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				encodeQueryFields(values, prefix, fv)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if isUnset(fv) || (strings.Contains(","+opts+",", ",omitempty,") && isEmptyValue(fv)) {
+			continue
+		}
+		encodeQueryValue(values, prefix+name, fv)
+	}
+}
+
+// encodeQueryValue adds the value of a field to values
+func encodeQueryValue(values url.Values, name string, v reflect.Value) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch {
+	case v.Kind() == reflect.Struct && !isQueryScalar(v):
+		encodeQueryFields(values, name+".", v)
+	case v.Kind() == reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			encodeQueryValue(values, name+"."+fmt.Sprint(iter.Key().Interface()), iter.Value())
+		}
+	case (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type().Elem().Kind() != reflect.Uint8:
+		var list []string
+		for i := 0; i < v.Len(); i++ {
+			if s, ok := queryString(v.Index(i)); ok {
+				list = append(list, s)
+			}
+		}
+		if len(list) > 0 {
+			values.Set(name, strings.Join(list, ","))
+		}
+	default:
+		if s, ok := queryString(v); ok {
+			values.Set(name, s)
+		}
+	}
+}
+
+// isQueryScalar reports whether a struct is sent as a single value rather than as its fields
+func isQueryScalar(v reflect.Value) bool {
+	switch v.Type() {
+	case dateTimeType, dateType, timeType:
+		return true
+	}
+	return v.Type().Implements(textMarshalerType)
+}
+
+// queryString formats a single value. It returns false for a value that is not sent.
+func queryString(v reflect.Value) (string, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+
+	switch v.Type() {
+	case dateTimeType:
+		t := v.Interface().(DateTime).Time
+		return t.UTC().Format("2006-01-02T15:04:05Z"), !t.IsZero()
+	case dateType:
+		t := v.Interface().(Date).Time
+		return t.Format("2006-01-02"), !t.IsZero()
+	case timeType:
+		t := v.Interface().(time.Time)
+		return t.UTC().Format("2006-01-02T15:04:05Z"), !t.IsZero()
+	}
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		return string(text), err == nil && len(text) > 0
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), v.Len() > 0
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32), true
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return string(v.Bytes()), v.Len() > 0
+		}
+	}
+	return "", false
+}
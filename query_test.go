@@ -0,0 +1,119 @@
+package eventbrite
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+type queryPage struct {
+	Page int `json:"page,omitempty"`
+}
+
+type queryLocation struct {
+	Address string `json:"address,omitempty"`
+	Within  string `json:"within"`
+}
+
+type queryRequest struct {
+	queryPage
+	Name     string            `json:"name,omitempty"`
+	Flag     bool              `json:"flag"`
+	Off      bool              `json:"off,omitempty"`
+	Count    int               `json:"count,omitempty"`
+	Ratio    float64           `json:"ratio,omitempty"`
+	IDs      []string          `json:"ids,omitempty"`
+	Mixed    []interface{}     `json:"mixed,omitempty"`
+	Raw      []byte            `json:"raw,omitempty"`
+	Since    DateTime          `json:"since"`
+	Day      Date              `json:"day"`
+	When     time.Time         `json:"when"`
+	Ptr      *bool             `json:"ptr"`
+	Location *queryLocation    `json:"location"`
+	Labels   map[string]string `json:"labels"`
+	Skipped  string            `json:"-"`
+	NoTag    string
+	internal string
+}
+
+func TestEncodeQuery(t *testing.T) {
+	paris := time.FixedZone("Europe/Paris", 3600)
+	no := false
+
+	tests := []struct {
+		name string
+		req  interface{}
+		want string
+	}{
+		{"nil", nil, ""},
+		{"typed nil", (*queryRequest)(nil), ""},
+		{"value", queryRequest{Name: "go"}, "flag=false&name=go"},
+		{"pointer", &queryRequest{Name: "go"}, "flag=false&name=go"},
+		{"false with omitempty", &queryRequest{Off: false}, "flag=false"},
+		{"true", &queryRequest{Flag: true, Off: true}, "flag=true&off=true"},
+		{"false pointer", &queryRequest{Ptr: &no}, "flag=false&ptr=false"},
+		{"numbers", &queryRequest{Count: 3, Ratio: 1.5}, "count=3&flag=false&ratio=1.5"},
+		{"slices", &queryRequest{IDs: []string{"1", "2"}, Mixed: []interface{}{3, "4"}}, "flag=false&ids=1%2C2&mixed=3%2C4"},
+		{"bytes", &queryRequest{Raw: []byte("abc")}, "flag=false&raw=abc"},
+		{"dates in UTC", &queryRequest{
+			Since: DateTime{Time: time.Date(2020, 1, 2, 4, 4, 5, 0, paris)},
+			Day:   Date{Time: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+			When:  time.Date(2020, 1, 2, 4, 4, 5, 0, paris),
+		}, "day=2020-01-02&flag=false&since=2020-01-02T03%3A04%3A05Z&when=2020-01-02T03%3A04%3A05Z"},
+		{"zero dates", &queryRequest{Since: DateTime{}, Day: Date{}, When: time.Time{}}, "flag=false"},
+		{"nested struct", &queryRequest{Location: &queryLocation{Address: "Paris", Within: "10km"}},
+			"flag=false&location.address=Paris&location.within=10km"},
+		{"map", &queryRequest{Labels: map[string]string{"a": "1", "b": "2"}}, "flag=false&labels.a=1&labels.b=2"},
+		{"embedded", &queryRequest{queryPage: queryPage{Page: 2}}, "flag=false&page=2"},
+		{"skipped", &queryRequest{Skipped: "x", internal: "y"}, "flag=false"},
+		{"no tag", &queryRequest{NoTag: "x"}, "NoTag=x&flag=false"},
+		{"url.Values", url.Values{"a": {"1", "2"}, "b": {"3"}}, "a=1&a=2&b=3"},
+		{"request type", &UserEventOrdersRequest{OnlyEmails: []string{"a@example.com"}},
+			"only_emails=a%40example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := encodeQuery(tt.req).Encode(); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeQueryCopiesValues(t *testing.T) {
+	req := url.Values{"a": {"1"}}
+	encodeQuery(req).Set("a", "2")
+
+	if got := req.Get("a"); got != "1" {
+		t.Errorf("the request was changed to %s", got)
+	}
+}
+
+type validatedRequest struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func TestGetQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     interface{}
+		wantErr bool
+	}{
+		{"nil", nil, false},
+		{"typed nil", (*EventSearchRequest)(nil), false},
+		{"url.Values", url.Values{}, false},
+		{"valid request", &validatedRequest{Name: "go"}, false},
+		{"invalid request", &validatedRequest{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := getQuery(context.Background(), tt.req); (err != nil) != tt.wantErr {
+				t.Errorf("got error %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,349 @@
+package eventbrite
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/oauth2"
+)
+
+// ErrClientClosed is returned for requests made after or interrupted by Client.Close
+var ErrClientClosed = errors.New("eventbrite: client closed")
+
+// DefaultRateLimits are the limits Eventbrite enforces per OAuth token: 2,000 requests per
+// hour and 48,000 per day, sent at most 5 per second
+var DefaultRateLimits = RateLimits{
+	PerSecond: 5,
+	Burst:     5,
+	PerHour:   2000,
+	PerDay:    48000,
+}
+
+// RateLimits describes the request budget of a token. Each window is a token bucket; a request
+// is sent once every window has a token available. A zero value disables the window.
+type RateLimits struct {
+	// Requests per second
+	PerSecond int
+	// Requests that may be sent at once on top of the per second rate. Defaults to PerSecond
+	Burst int
+	// Requests per hour
+	PerHour int
+	// Requests per day
+	PerDay int
+}
+
+// RateBudget is the number of requests currently available in each window. A window that
+// is not limited reports -1.
+type RateBudget struct {
+	Second int
+	Hour   int
+	Day    int
+}
+
+// WithRateLimits configures the per second, hourly and daily request budget. Default is
+// DefaultRateLimits.
+func WithRateLimits(limits RateLimits) ClientOption {
+	return func(c *Client) error {
+		c.rateLimits = limits
+		return nil
+	}
+}
+
+// RateBudget returns the number of requests the client may currently send in each window with
+// its own token
+func (c *Client) RateBudget() RateBudget {
+	return c.limiter.Remaining()
+}
+
+// RateLimiter decides when a request may be sent. A RateLimiter can be shared by several clients
+// using the same token, so they share a single budget.
+type RateLimiter interface {
+	// Wait blocks until a request may be sent and counts it against the budget
+	Wait(ctx context.Context) error
+	// Update adapts the limiter to the rate limit headers of a response
+	Update(resp *http.Response)
+	// Remaining returns the number of requests currently available in each window
+	Remaining() RateBudget
+}
+
+// WithRateLimiter configures the client to wait on the given rate limiter instead of creating
+// its own from the configured RateLimits. The requests made with WithRequestToken use the
+// limiters of WithTokenRateLimiter instead.
+func WithRateLimiter(l RateLimiter) ClientOption {
+	return func(c *Client) error {
+		c.limiter = l
+		return nil
+	}
+}
+
+// WithTokenRateLimiter configures the rate limiter of the requests made with a token given by
+// WithRequestToken, which have a budget of their own. newLimiter is called once per token,
+// e.g. with SharedRateLimiter to share the budget with the other clients of the process.
+// Default is an in-memory RateLimiter with the configured RateLimits.
+func WithTokenRateLimiter(newLimiter func(token string) RateLimiter) ClientOption {
+	return func(c *Client) error {
+		c.newTokenLimiter = newLimiter
+		return nil
+	}
+}
+
+// tokenLimiter is the rate limiter of a token given by WithRequestToken, along with the queue of
+// its requests, so the requests of a token never wait behind the budget of another
+type tokenLimiter struct {
+	limiter   RateLimiter
+	scheduler *scheduler
+}
+
+// limiterFor returns the rate limiter and the queue of the token of the request made with ctx
+func (c *Client) limiterFor(ctx context.Context) (RateLimiter, *scheduler) {
+	t, ok := ctx.Value(tokenKey{}).(*oauth2.Token)
+	if !ok {
+		return c.limiter, c.scheduler
+	}
+
+	c.tokenLimitersMu.Lock()
+	defer c.tokenLimitersMu.Unlock()
+
+	key := tokenHash(t.AccessToken)
+	tl, ok := c.tokenLimiters[key]
+	if !ok {
+		tl = &tokenLimiter{scheduler: newScheduler()}
+		tl.scheduler.maxWait = c.scheduler.maxWait
+		if c.newTokenLimiter != nil {
+			tl.limiter = c.newTokenLimiter(t.AccessToken)
+		} else {
+			tl.limiter = NewRateLimiter(c.rateLimits)
+		}
+		if c.tokenLimiters == nil {
+			c.tokenLimiters = map[string]*tokenLimiter{}
+		}
+		c.tokenLimiters[key] = tl
+	}
+	return tl.limiter, tl.scheduler
+}
+
+// tokenHash returns a short hash of the token, to tell tokens apart without keeping them
+func tokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:8])
+}
+
+// NewRateLimiter returns an in-memory RateLimiter. Pass it to every client that should share
+// the budget.
+func NewRateLimiter(limits RateLimits) RateLimiter {
+	return &memoryLimiter{w: newWindows(limits, time.Now())}
+}
+
+var (
+	sharedLimitersMu sync.Mutex
+	// the limiters by hash of their token, so the tokens are not kept in memory
+	sharedLimiters = map[string]RateLimiter{}
+)
+
+// SharedRateLimiter returns the in-memory RateLimiter of the token, creating it with the given
+// limits on first use. All clients of the process built with it share one budget per token.
+func SharedRateLimiter(token string, limits RateLimits) RateLimiter {
+	sharedLimitersMu.Lock()
+	defer sharedLimitersMu.Unlock()
+
+	key := tokenHash(token)
+	l, ok := sharedLimiters[key]
+	if !ok {
+		l = NewRateLimiter(limits)
+		sharedLimiters[key] = l
+	}
+	return l
+}
+
+// bucket is a token bucket of the given capacity refilled continuously over the period
+type bucket struct {
+	capacity float64
+	perNano  float64
+	Tokens   float64   `json:"tokens"`
+	Last     time.Time `json:"last"`
+}
+
+func newBucket(capacity int, period time.Duration, now time.Time) *bucket {
+	if capacity <= 0 {
+		return nil
+	}
+	return &bucket{
+		capacity: float64(capacity),
+		perNano:  float64(capacity) / float64(period),
+		Tokens:   float64(capacity),
+		Last:     now,
+	}
+}
+
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.Last); elapsed > 0 {
+		b.Tokens = math.Min(b.capacity, b.Tokens+float64(elapsed)*b.perNano)
+		b.Last = now
+	}
+}
+
+// wait returns how long until the bucket holds a whole token
+func (b *bucket) wait() time.Duration {
+	if b.Tokens >= 1 {
+		return 0
+	}
+	return time.Duration(math.Ceil((1 - b.Tokens) / b.perNano))
+}
+
+// windows combines the second, hour and day buckets. Buckets are refilled lazily whenever
+// they are looked at, so no goroutine is needed to keep them up to date. windows is not safe
+// for concurrent use.
+type windows struct {
+	Second  *bucket   `json:"second,omitempty"`
+	Hour    *bucket   `json:"hour,omitempty"`
+	Day     *bucket   `json:"day,omitempty"`
+	Blocked time.Time `json:"blocked"`
+}
+
+func newWindows(limits RateLimits, now time.Time) *windows {
+	w := &windows{
+		Hour: newBucket(limits.PerHour, time.Hour, now),
+		Day:  newBucket(limits.PerDay, 24*time.Hour, now),
+	}
+	if limits.PerSecond > 0 {
+		burst := limits.Burst
+		if burst <= 0 {
+			burst = limits.PerSecond
+		}
+		w.Second = newBucket(burst, time.Duration(float64(time.Second)*float64(burst)/float64(limits.PerSecond)), now)
+	}
+	return w
+}
+
+func (w *windows) buckets() []*bucket {
+	var bs []*bucket
+	for _, b := range []*bucket{w.Second, w.Hour, w.Day} {
+		if b != nil {
+			bs = append(bs, b)
+		}
+	}
+	return bs
+}
+
+// take consumes a token from every window, or returns how long to wait for one
+func (w *windows) take(now time.Time) time.Duration {
+	if now.Before(w.Blocked) {
+		return w.Blocked.Sub(now)
+	}
+
+	var d time.Duration
+	bs := w.buckets()
+	for _, b := range bs {
+		b.refill(now)
+		if wait := b.wait(); wait > d {
+			d = wait
+		}
+	}
+	if d > 0 {
+		return d
+	}
+	for _, b := range bs {
+		b.Tokens--
+	}
+	return 0
+}
+
+// update adapts the buckets to the rate limit headers of a response. The remaining hourly
+// budget reported by the API caps the hour bucket, and a rate limited response blocks all
+// requests until the reset or Retry-After time.
+func (w *windows) update(resp *http.Response, now time.Time) {
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil && w.Hour != nil {
+		w.Hour.refill(now)
+		w.Hour.Tokens = math.Min(w.Hour.Tokens, float64(remaining))
+		if remaining <= 0 {
+			if reset, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Reset")); err == nil {
+				w.block(now.Add(time.Duration(reset) * time.Second))
+			}
+		}
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if after, ok := retryAfter(resp.Header, now); ok {
+			w.block(now.Add(after))
+		}
+	}
+}
+
+func (w *windows) block(until time.Time) {
+	if until.After(w.Blocked) {
+		w.Blocked = until
+	}
+}
+
+func (w *windows) remaining(now time.Time) RateBudget {
+	count := func(b *bucket) int {
+		if b == nil {
+			return -1
+		}
+		b.refill(now)
+		return int(b.Tokens)
+	}
+	return RateBudget{
+		Second: count(w.Second),
+		Hour:   count(w.Hour),
+		Day:    count(w.Day),
+	}
+}
+
+// memoryLimiter is a RateLimiter shared by the clients of a single process
+type memoryLimiter struct {
+	mu sync.Mutex
+	w  *windows
+}
+
+func (l *memoryLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		d := l.w.take(time.Now())
+		l.mu.Unlock()
+
+		if d == 0 {
+			return nil
+		}
+		if err := sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+}
+
+func (l *memoryLimiter) Update(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.w.update(resp, time.Now())
+}
+
+func (l *memoryLimiter) Remaining() RateBudget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.w.remaining(time.Now())
+}
+
+// sleep waits for the duration or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
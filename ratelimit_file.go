@@ -0,0 +1,124 @@
+package eventbrite
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// how long to wait between two attempts to take the lock file
+const fileLockRetry = 5 * time.Millisecond
+
+// fileLimiter is a RateLimiter whose state is kept in a file, so the processes of a host
+// share one budget per token. Access to the state file is serialized with a lock file.
+type fileLimiter struct {
+	limits   RateLimits
+	path     string
+	lockPath string
+}
+
+// NewFileRateLimiter returns a RateLimiter shared by all processes of the host that use the
+// same directory and token. The budget is stored in dir in a file named after a hash of the
+// token, so the token itself is never written to disk.
+func NewFileRateLimiter(dir, token string, limits RateLimits) (RateLimiter, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	name := "eventbrite-" + tokenHash(token)
+
+	return &fileLimiter{
+		limits:   limits,
+		path:     filepath.Join(dir, name+".json"),
+		lockPath: filepath.Join(dir, name+".lock"),
+	}, nil
+}
+
+func (l *fileLimiter) Wait(ctx context.Context) error {
+	for {
+		var d time.Duration
+		err := l.withWindows(ctx, func(w *windows) {
+			d = w.take(time.Now())
+		})
+		if err != nil {
+			return err
+		}
+
+		if d == 0 {
+			return nil
+		}
+		if err := sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+}
+
+func (l *fileLimiter) Update(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+	l.withWindows(context.Background(), func(w *windows) {
+		w.update(resp, time.Now())
+	})
+}
+
+func (l *fileLimiter) Remaining() RateBudget {
+	budget := RateBudget{Second: -1, Hour: -1, Day: -1}
+	l.withWindows(context.Background(), func(w *windows) {
+		budget = w.remaining(time.Now())
+	})
+	return budget
+}
+
+// withWindows locks the state file, loads the windows, applies fn and stores the result
+func (l *fileLimiter) withWindows(ctx context.Context, fn func(w *windows)) error {
+	unlock, err := lockFile(ctx, l.lockPath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	w := newWindows(l.limits, time.Now())
+	if data, err := os.ReadFile(l.path); err == nil {
+		saved := windows{}
+		if json.Unmarshal(data, &saved) == nil {
+			restoreBucket(w.Second, saved.Second)
+			restoreBucket(w.Hour, saved.Hour)
+			restoreBucket(w.Day, saved.Day)
+			w.Blocked = saved.Blocked
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	fn(w)
+
+	data, err := json.Marshal(w)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so a crash never leaves a truncated state behind
+	tmp := l.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, l.path)
+}
+
+// restoreBucket copies the saved level into a bucket built from the current limits. Windows
+// that are disabled by the current limits are ignored.
+func restoreBucket(b, saved *bucket) {
+	if b == nil || saved == nil {
+		return
+	}
+	b.Tokens = saved.Tokens
+	b.Last = saved.Last
+	if b.Tokens > b.capacity {
+		b.Tokens = b.capacity
+	}
+}
@@ -0,0 +1,64 @@
+//go:build !unix
+
+package eventbrite
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// a lock file older than this is left over by a crashed process and is removed
+const fileLockStale = 10 * time.Second
+
+// lockFile takes an exclusive lock by creating the lock file, waiting while another process
+// holds it. The lock file holds a nonce of its owner: the returned function releases the lock
+// by removing the file only while it holds that nonce, and a stale lock is only removed while
+// it holds the nonce it was found stale with.
+func lockFile(ctx context.Context, path string) (func(), error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	owner := []byte(hex.EncodeToString(nonce))
+
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			_, err = f.Write(owner)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				os.Remove(path)
+				return nil, err
+			}
+			return func() { removeOwned(path, owner) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		if fi, err := os.Stat(path); err == nil && time.Since(fi.ModTime()) > fileLockStale {
+			if stale, err := os.ReadFile(path); err == nil {
+				removeOwned(path, stale)
+				continue
+			}
+		}
+
+		if err := sleep(ctx, fileLockRetry); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// removeOwned removes the lock file if it still holds the nonce of owner
+func removeOwned(path string, owner []byte) {
+	if data, err := os.ReadFile(path); err == nil && bytes.Equal(data, owner) {
+		os.Remove(path)
+	}
+}
@@ -0,0 +1,57 @@
+//go:build !unix
+
+package eventbrite
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestLockFileStale(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.lock")
+
+	// a lock left over by a crashed process
+	if err := os.WriteFile(path, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-fileLockStale - time.Second)
+	os.Chtimes(path, old, old)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	unlock, err := lockFile(ctx, path)
+	if err != nil {
+		t.Fatalf("lockFile on a stale lock = %v, want it taken over", err)
+	}
+	unlock()
+
+	// a recent lock is not
+	os.WriteFile(path, nil, 0600)
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := lockFile(ctx, path); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("lockFile on a recent lock = %v, want to wait", err)
+	}
+}
+
+func TestLockFileOwner(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.lock")
+
+	unlock, err := lockFile(context.Background(), path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the lock was taken over and is now held by another process
+	if err := os.WriteFile(path, []byte("other"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("unlock removed the lock of another owner: %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package eventbrite
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestFileRateLimiterSharesBudget(t *testing.T) {
+	dir := t.TempDir()
+	limits := RateLimits{PerHour: 5}
+
+	a, err := NewFileRateLimiter(dir, "token-a", limits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := NewFileRateLimiter(dir, "token-a", limits)
+	other, _ := NewFileRateLimiter(dir, "token-b", limits)
+
+	for _, l := range []RateLimiter{a, b, a} {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := b.Remaining().Hour; got != 2 {
+		t.Errorf("shared hour budget = %d, want 2", got)
+	}
+	if got := other.Remaining().Hour; got != 5 {
+		t.Errorf("hour budget of another token = %d, want 5", got)
+	}
+
+	// a 429 seen by one limiter blocks the other
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"60"}}}
+	a.Update(resp)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := b.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait after a 429 = %v, want to be blocked", err)
+	}
+}
+
+func TestFileRateLimiterNoTokenOnDisk(t *testing.T) {
+	dir := t.TempDir()
+	l, _ := NewFileRateLimiter(dir, "secret-token", RateLimits{PerHour: 5})
+	l.Wait(context.Background())
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*"))
+	if len(files) == 0 {
+		t.Fatal("no state file written")
+	}
+	for _, f := range files {
+		data, _ := os.ReadFile(f)
+		if strings.Contains(f, "secret-token") || strings.Contains(string(data), "secret-token") {
+			t.Errorf("the token is written to %s", f)
+		}
+	}
+}
+
+func TestFileRateLimiterConcurrent(t *testing.T) {
+	dir := t.TempDir()
+	const n = 30
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// every goroutine has its own limiter, as separate processes would
+			l, _ := NewFileRateLimiter(dir, "token", RateLimits{PerHour: 100})
+			if err := l.Wait(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	l, _ := NewFileRateLimiter(dir, "token", RateLimits{PerHour: 100})
+	if got := l.Remaining().Hour; got != 100-n {
+		t.Errorf("hour budget = %d, want %d: updates were lost", got, 100-n)
+	}
+}
+
+// TestFileRateLimiterProcesses draws from one budget in several processes, running this test
+// binary again in helper mode
+func TestFileRateLimiterProcesses(t *testing.T) {
+	if dir := os.Getenv("EVENTBRITE_LIMITER_DIR"); dir != "" {
+		l, _ := NewFileRateLimiter(dir, "token", RateLimits{PerHour: 100})
+		for i := 0; i < 10; i++ {
+			if err := l.Wait(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return
+	}
+
+	dir := t.TempDir()
+	const processes = 4
+
+	var wg sync.WaitGroup
+	for i := 0; i < processes; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cmd := exec.Command(os.Args[0], "-test.run=^TestFileRateLimiterProcesses$")
+			cmd.Env = append(os.Environ(), "EVENTBRITE_LIMITER_DIR="+dir)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("helper process: %v\n%s", err, out)
+			}
+		}()
+	}
+	wg.Wait()
+
+	l, _ := NewFileRateLimiter(dir, "token", RateLimits{PerHour: 100})
+	if got := l.Remaining().Hour; got != 100-processes*10 {
+		t.Errorf("hour budget = %d, want %d", got, 100-processes*10)
+	}
+}
+
+func TestLockFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.lock")
+
+	unlock, err := lockFile(context.Background(), path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a held lock makes the others wait
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := lockFile(ctx, path); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("lockFile on a held lock = %v, want to wait", err)
+	}
+
+	unlock()
+	unlock, err = lockFile(context.Background(), path)
+	if err != nil {
+		t.Fatalf("lockFile after unlock: %v", err)
+	}
+	unlock()
+}
+
+func TestLockFileExclusive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.lock")
+
+	var holders, overlaps int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock, err := lockFile(context.Background(), path)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if atomic.AddInt32(&holders, 1) > 1 {
+				atomic.AddInt32(&overlaps, 1)
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&holders, -1)
+			unlock()
+		}()
+	}
+	wg.Wait()
+
+	if overlaps > 0 {
+		t.Errorf("the lock was held %d times by two goroutines at once", overlaps)
+	}
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	// the limiters outlive the test, so each run gets its own tokens
+	token := "shared-token-" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	a := SharedRateLimiter(token+"-a", RateLimits{PerHour: 10})
+	if b := SharedRateLimiter(token+"-a", RateLimits{PerHour: 99}); a != b {
+		t.Error("SharedRateLimiter returned two limiters for one token")
+	}
+	if other := SharedRateLimiter(token+"-b", RateLimits{PerHour: 10}); a == other {
+		t.Error("SharedRateLimiter shares the limiter of another token")
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"categories": []}`))
+	}
+	c1 := newTestClient(t, handler, WithRateLimiter(a))
+	c2 := newTestClient(t, handler, WithRateLimiter(a))
+	c1.Categories(context.Background())
+	c2.Categories(context.Background())
+
+	if got := c1.RateBudget().Hour; got != 8 {
+		t.Errorf("hour budget = %d, want 8 after a request of each client", got)
+	}
+
+	// closing a client leaves the shared limiter working for the other
+	c1.Close()
+	if _, err := c2.Categories(context.Background()); err != nil {
+		t.Errorf("request after closing the other client: %v", err)
+	}
+}
@@ -0,0 +1,38 @@
+//go:build unix
+
+package eventbrite
+
+import (
+	"os"
+	"syscall"
+
+	"golang.org/x/net/context"
+)
+
+// lockFile takes an exclusive flock on the lock file, waiting while another process holds it.
+// The lock of a crashed process is released with its file descriptors, so the lock file is
+// never removed. The returned function releases the lock.
+func lockFile(ctx context.Context, path string) (func(), error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+		if err == nil {
+			return func() {
+				syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+				f.Close()
+			}, nil
+		}
+		if err != syscall.EWOULDBLOCK && err != syscall.EINTR {
+			f.Close()
+			return nil, err
+		}
+
+		if err := sleep(ctx, fileLockRetry); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,33 @@
+//go:build unix
+
+package eventbrite
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestLockFileLeftOver(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.lock")
+
+	// the lock file of a crashed process holds no lock
+	if err := os.WriteFile(path, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	unlock, err := lockFile(ctx, path)
+	if err != nil {
+		t.Fatalf("lockFile on a left over lock file = %v, want it taken", err)
+	}
+	unlock()
+
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("unlock removed the lock file: %v", err)
+	}
+}
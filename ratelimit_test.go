@@ -0,0 +1,245 @@
+package eventbrite
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestWindowsTake(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	w := newWindows(RateLimits{PerSecond: 2, Burst: 2, PerHour: 3}, now)
+
+	for i := 0; i < 2; i++ {
+		if d := w.take(now); d != 0 {
+			t.Fatalf("take %d = %v, want 0 within the burst", i, d)
+		}
+	}
+	if d := w.take(now); d != 500*time.Millisecond {
+		t.Fatalf("take past the burst = %v, want 500ms", d)
+	}
+
+	// the failed take consumed nothing: half a second later one token is back
+	now = now.Add(500 * time.Millisecond)
+	if d := w.take(now); d != 0 {
+		t.Fatalf("take after refill = %v, want 0", d)
+	}
+
+	// the hour bucket of 3 is now empty and refills one token every 20 minutes
+	now = now.Add(time.Second)
+	if d := w.take(now); d <= 19*time.Minute || d > 20*time.Minute {
+		t.Fatalf("take with an empty hour bucket = %v, want about 20m", d)
+	}
+	if got := w.remaining(now); got.Hour != 0 || got.Day != -1 {
+		t.Errorf("remaining = %+v, want no hourly request left and no daily window", got)
+	}
+}
+
+func TestWindowsBurstDefaultsToRate(t *testing.T) {
+	now := time.Now()
+	w := newWindows(RateLimits{PerSecond: 3}, now)
+
+	if got := w.remaining(now).Second; got != 3 {
+		t.Errorf("second budget = %d, want 3", got)
+	}
+}
+
+func TestWindowsDisabled(t *testing.T) {
+	now := time.Now()
+	w := newWindows(RateLimits{}, now)
+
+	for i := 0; i < 1000; i++ {
+		if d := w.take(now); d != 0 {
+			t.Fatalf("take %d = %v, want no limit", i, d)
+		}
+	}
+	if got := w.remaining(now); got != (RateBudget{-1, -1, -1}) {
+		t.Errorf("remaining = %+v, want every window disabled", got)
+	}
+}
+
+func TestWindowsUpdate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	response := func(status int, headers map[string]string) *http.Response {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}}
+		for k, v := range headers {
+			resp.Header.Set(k, v)
+		}
+		return resp
+	}
+
+	t.Run("remaining caps the hour bucket", func(t *testing.T) {
+		w := newWindows(RateLimits{PerHour: 100}, now)
+		w.update(response(200, map[string]string{"X-RateLimit-Remaining": "10"}), now)
+		if got := w.remaining(now).Hour; got != 10 {
+			t.Errorf("hour budget = %d, want 10", got)
+		}
+	})
+
+	t.Run("exhausted budget blocks until the reset", func(t *testing.T) {
+		w := newWindows(RateLimits{PerHour: 100}, now)
+		w.update(response(200, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "60"}), now)
+		if d := w.take(now); d != time.Minute {
+			t.Errorf("take = %v, want 1m", d)
+		}
+	})
+
+	t.Run("429 blocks until Retry-After", func(t *testing.T) {
+		w := newWindows(RateLimits{PerSecond: 5}, now)
+		w.update(response(http.StatusTooManyRequests, map[string]string{"Retry-After": "30"}), now)
+		if d := w.take(now.Add(10 * time.Second)); d != 20*time.Second {
+			t.Errorf("take = %v, want 20s", d)
+		}
+		if d := w.take(now.Add(30 * time.Second)); d != 0 {
+			t.Errorf("take after Retry-After = %v, want 0", d)
+		}
+	})
+}
+
+func TestMemoryLimiterWait(t *testing.T) {
+	l := NewRateLimiter(RateLimits{PerSecond: 10, Burst: 1})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("3 requests at 10/s with a burst of 1 took %v, want at least 200ms", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	l = NewRateLimiter(RateLimits{PerHour: 1})
+	l.Wait(ctx)
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait with an empty budget = %v, want the context error", err)
+	}
+}
+
+func TestMemoryLimiterConcurrent(t *testing.T) {
+	l := NewRateLimiter(RateLimits{PerHour: 50})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.Wait(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := l.Remaining().Hour; got != 0 {
+		t.Errorf("hour budget = %d, want 0 after 50 requests", got)
+	}
+}
+
+func TestClientRateBudget(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"categories": []}`))
+	}, WithRateLimits(RateLimits{PerHour: 10}))
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.Categories(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := c.RateBudget().Hour; got != 7 {
+		t.Errorf("hour budget = %d, want 7", got)
+	}
+}
+
+func TestClientTokenRateLimiter(t *testing.T) {
+	tests := []struct {
+		name       string
+		factory    bool
+		wantTokens []string
+	}{
+		{"default", false, nil},
+		{"factory", true, []string{"org-1", "org-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var made []string
+			opts := []ClientOption{WithRateLimits(RateLimits{PerHour: 1})}
+			if tt.factory {
+				opts = append(opts, WithTokenRateLimiter(func(token string) RateLimiter {
+					made = append(made, token)
+					return NewRateLimiter(RateLimits{PerHour: 1})
+				}))
+			}
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"categories": []}`))
+			}, opts...)
+			ctx := context.Background()
+
+			// each token spends its own budget of one request
+			for _, ctx := range []context.Context{ctx, WithRequestToken(ctx, "org-1"), WithRequestToken(ctx, "org-2")} {
+				if _, err := c.Categories(ctx); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, ctx := range []context.Context{ctx, WithRequestToken(ctx, "org-1")} {
+				ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+				_, err := c.Categories(ctx)
+				cancel()
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("got error %v with a spent budget, want the context error", err)
+				}
+			}
+
+			if fmt.Sprint(made) != fmt.Sprint(tt.wantTokens) {
+				t.Errorf("made limiters for %v, want %v", made, tt.wantTokens)
+			}
+			if got := c.RateBudget().Hour; got != 0 {
+				t.Errorf("hour budget of the client token = %d, want 0", got)
+			}
+		})
+	}
+}
+
+func TestClientCloseWakesWaiters(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.Itoa(3600))
+		w.Write([]byte(`{"categories": []}`))
+	}, WithRateLimits(RateLimits{PerHour: 10}))
+
+	// the first response exhausts the budget for an hour
+	if _, err := c.Categories(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		_, err := c.Categories(context.Background())
+		errc <- err
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	c.Close()
+
+	select {
+	case err := <-errc:
+		if !errors.Is(err, ErrClientClosed) {
+			t.Errorf("err = %v, want ErrClientClosed", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the waiting request was not woken by Close")
+	}
+
+	if _, err := c.Categories(context.Background()); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("request after Close = %v, want ErrClientClosed", err)
+	}
+}
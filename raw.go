@@ -0,0 +1,163 @@
+package eventbrite
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+//go:generate go run ./internal/rawgen
+
+// RawFields keeps the JSON an object of the API was decoded from. It is embedded in the
+// response objects, so the fields Eventbrite added before this library can be read with
+// Extra or Field, and the whole payload archived with Raw.
+//
+// Encoding an object decoded from the API returns that JSON as long as the object is not
+// modified. Otherwise the unknown fields are encoded along with the struct fields.
+type RawFields struct {
+	raw   json.RawMessage
+	extra map[string]json.RawMessage
+}
+
+// Raw returns the JSON the object was decoded from, or nil if it was not decoded
+func (r RawFields) Raw() json.RawMessage {
+	return r.raw
+}
+
+// Extra returns the fields of the JSON the object has no struct field for
+func (r RawFields) Extra() map[string]json.RawMessage {
+	return r.extra
+}
+
+// Field decodes the field of the JSON with the given name into v. It returns false when the
+// JSON has no such field.
+func (r RawFields) Field(name string, v interface{}) (bool, error) {
+	if len(r.raw) == 0 {
+		return false, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(r.raw, &fields); err != nil {
+		return false, err
+	}
+	f, ok := fields[name]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(f, v)
+}
+
+var rawFieldsType = reflect.TypeOf(RawFields{})
+
+// unmarshalRaw decodes data into v, a pointer to a type without the JSON methods of the
+// object r belongs to, and keeps data and its unknown fields in r
+func unmarshalRaw(data []byte, v interface{}, r *RawFields) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	r.raw = append(json.RawMessage(nil), data...)
+	r.extra = nil
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		// not an object, so there are no fields to keep
+		return nil
+	}
+	known := knownFields(reflect.TypeOf(v).Elem())
+	for name, f := range fields {
+		if !known[strings.ToLower(name)] {
+			if r.extra == nil {
+				r.extra = map[string]json.RawMessage{}
+			}
+			r.extra[name] = f
+		}
+	}
+	return nil
+}
+
+// marshalRaw encodes v, a value of a type without the JSON methods of the object r belongs to.
+// The original JSON is returned when v is unchanged since it was decoded, whatever its size,
+// as encoding v would lose the fields set to their zero value and tagged omitempty.
+func marshalRaw(v interface{}, r RawFields) ([]byte, error) {
+	if r.raw != nil && unchanged(v, r.raw) {
+		return r.raw, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil || len(r.extra) == 0 {
+		return data, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name, f := range r.extra {
+		if _, ok := fields[name]; !ok {
+			fields[name] = f
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// unchanged reports whether v equals the value decoded from raw
+func unchanged(v interface{}, raw json.RawMessage) bool {
+	t := reflect.TypeOf(v)
+	decoded := reflect.New(t)
+	if err := json.Unmarshal(raw, decoded.Interface()); err != nil {
+		return false
+	}
+	current := reflect.New(t).Elem()
+	current.Set(reflect.ValueOf(v))
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Type == rawFieldsType {
+			current.Field(i).Set(reflect.Zero(rawFieldsType))
+		}
+	}
+	return reflect.DeepEqual(current.Interface(), decoded.Elem().Interface())
+}
+
+var knownFieldsCache sync.Map
+
+// knownFields returns the lowercased names of the JSON fields decoded into the struct type,
+// as encoding/json matches them case-insensitively
+func knownFields(t reflect.Type) map[string]bool {
+	if known, ok := knownFieldsCache.Load(t); ok {
+		return known.(map[string]bool)
+	}
+	known := map[string]bool{}
+	addKnownFields(known, t)
+	knownFieldsCache.Store(t, known)
+	return known
+}
+
+func addKnownFields(known map[string]bool, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				addKnownFields(known, ft)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		known[strings.ToLower(name)] = true
+	}
+}
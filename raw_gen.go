@@ -0,0 +1,643 @@
+// Code generated by internal/rawgen. DO NOT EDIT.
+
+package eventbrite
+
+func (a *Assortment) UnmarshalJSON(data []byte) error {
+	type assortment Assortment
+	return unmarshalRaw(data, (*assortment)(a), &a.RawFields)
+}
+
+func (a Assortment) MarshalJSON() ([]byte, error) {
+	type assortment Assortment
+	return marshalRaw(assortment(a), a.RawFields)
+}
+
+func (a *Attendee) UnmarshalJSON(data []byte) error {
+	type attendee Attendee
+	return unmarshalRaw(data, (*attendee)(a), &a.RawFields)
+}
+
+func (a Attendee) MarshalJSON() ([]byte, error) {
+	type attendee Attendee
+	return marshalRaw(attendee(a), a.RawFields)
+}
+
+func (c *CancelResult) UnmarshalJSON(data []byte) error {
+	type cancelResult CancelResult
+	return unmarshalRaw(data, (*cancelResult)(c), &c.RawFields)
+}
+
+func (c CancelResult) MarshalJSON() ([]byte, error) {
+	type cancelResult CancelResult
+	return marshalRaw(cancelResult(c), c.RawFields)
+}
+
+func (c *CategoriesResult) UnmarshalJSON(data []byte) error {
+	type categoriesResult CategoriesResult
+	return unmarshalRaw(data, (*categoriesResult)(c), &c.RawFields)
+}
+
+func (c CategoriesResult) MarshalJSON() ([]byte, error) {
+	type categoriesResult CategoriesResult
+	return marshalRaw(categoriesResult(c), c.RawFields)
+}
+
+func (c *Category) UnmarshalJSON(data []byte) error {
+	type category Category
+	return unmarshalRaw(data, (*category)(c), &c.RawFields)
+}
+
+func (c Category) MarshalJSON() ([]byte, error) {
+	type category Category
+	return marshalRaw(category(c), c.RawFields)
+}
+
+func (c *Checkout) UnmarshalJSON(data []byte) error {
+	type checkout Checkout
+	return unmarshalRaw(data, (*checkout)(c), &c.RawFields)
+}
+
+func (c Checkout) MarshalJSON() ([]byte, error) {
+	type checkout Checkout
+	return marshalRaw(checkout(c), c.RawFields)
+}
+
+func (c *CheckoutMethodsResponse) UnmarshalJSON(data []byte) error {
+	type checkoutMethodsResponse CheckoutMethodsResponse
+	return unmarshalRaw(data, (*checkoutMethodsResponse)(c), &c.RawFields)
+}
+
+func (c CheckoutMethodsResponse) MarshalJSON() ([]byte, error) {
+	type checkoutMethodsResponse CheckoutMethodsResponse
+	return marshalRaw(checkoutMethodsResponse(c), c.RawFields)
+}
+
+func (c *CheckoutSettingsForAccount) UnmarshalJSON(data []byte) error {
+	type checkoutSettingsForAccount CheckoutSettingsForAccount
+	return unmarshalRaw(data, (*checkoutSettingsForAccount)(c), &c.RawFields)
+}
+
+func (c CheckoutSettingsForAccount) MarshalJSON() ([]byte, error) {
+	type checkoutSettingsForAccount CheckoutSettingsForAccount
+	return marshalRaw(checkoutSettingsForAccount(c), c.RawFields)
+}
+
+func (c *Contact) UnmarshalJSON(data []byte) error {
+	type contact Contact
+	return unmarshalRaw(data, (*contact)(c), &c.RawFields)
+}
+
+func (c Contact) MarshalJSON() ([]byte, error) {
+	type contact Contact
+	return marshalRaw(contact(c), c.RawFields)
+}
+
+func (c *ContactList) UnmarshalJSON(data []byte) error {
+	type contactList ContactList
+	return unmarshalRaw(data, (*contactList)(c), &c.RawFields)
+}
+
+func (c ContactList) MarshalJSON() ([]byte, error) {
+	type contactList ContactList
+	return marshalRaw(contactList(c), c.RawFields)
+}
+
+func (c *Countries) UnmarshalJSON(data []byte) error {
+	type countries Countries
+	return unmarshalRaw(data, (*countries)(c), &c.RawFields)
+}
+
+func (c Countries) MarshalJSON() ([]byte, error) {
+	type countries Countries
+	return marshalRaw(countries(c), c.RawFields)
+}
+
+func (c *Country) UnmarshalJSON(data []byte) error {
+	type country Country
+	return unmarshalRaw(data, (*country)(c), &c.RawFields)
+}
+
+func (c Country) MarshalJSON() ([]byte, error) {
+	type country Country
+	return marshalRaw(country(c), c.RawFields)
+}
+
+func (c *CreateResult) UnmarshalJSON(data []byte) error {
+	type createResult CreateResult
+	return unmarshalRaw(data, (*createResult)(c), &c.RawFields)
+}
+
+func (c CreateResult) MarshalJSON() ([]byte, error) {
+	type createResult CreateResult
+	return marshalRaw(createResult(c), c.RawFields)
+}
+
+func (c *CrossEventDiscount) UnmarshalJSON(data []byte) error {
+	type crossEventDiscount CrossEventDiscount
+	return unmarshalRaw(data, (*crossEventDiscount)(c), &c.RawFields)
+}
+
+func (c CrossEventDiscount) MarshalJSON() ([]byte, error) {
+	type crossEventDiscount CrossEventDiscount
+	return marshalRaw(crossEventDiscount(c), c.RawFields)
+}
+
+func (d *DeleteResult) UnmarshalJSON(data []byte) error {
+	type deleteResult DeleteResult
+	return unmarshalRaw(data, (*deleteResult)(d), &d.RawFields)
+}
+
+func (d DeleteResult) MarshalJSON() ([]byte, error) {
+	type deleteResult DeleteResult
+	return marshalRaw(deleteResult(d), d.RawFields)
+}
+
+func (e *Event) UnmarshalJSON(data []byte) error {
+	type event Event
+	return unmarshalRaw(data, (*event)(e), &e.RawFields)
+}
+
+func (e Event) MarshalJSON() ([]byte, error) {
+	type event Event
+	return marshalRaw(event(e), e.RawFields)
+}
+
+func (e *EventCheckoutSettings) UnmarshalJSON(data []byte) error {
+	type eventCheckoutSettings EventCheckoutSettings
+	return unmarshalRaw(data, (*eventCheckoutSettings)(e), &e.RawFields)
+}
+
+func (e EventCheckoutSettings) MarshalJSON() ([]byte, error) {
+	type eventCheckoutSettings EventCheckoutSettings
+	return marshalRaw(eventCheckoutSettings(e), e.RawFields)
+}
+
+func (e *EventGetTicketClassResult) UnmarshalJSON(data []byte) error {
+	type eventGetTicketClassResult EventGetTicketClassResult
+	return unmarshalRaw(data, (*eventGetTicketClassResult)(e), &e.RawFields)
+}
+
+func (e EventGetTicketClassResult) MarshalJSON() ([]byte, error) {
+	type eventGetTicketClassResult EventGetTicketClassResult
+	return marshalRaw(eventGetTicketClassResult(e), e.RawFields)
+}
+
+func (e *EventSearchResult) UnmarshalJSON(data []byte) error {
+	type eventSearchResult EventSearchResult
+	return unmarshalRaw(data, (*eventSearchResult)(e), &e.RawFields)
+}
+
+func (e EventSearchResult) MarshalJSON() ([]byte, error) {
+	type eventSearchResult EventSearchResult
+	return marshalRaw(eventSearchResult(e), e.RawFields)
+}
+
+func (e *EventSettings) UnmarshalJSON(data []byte) error {
+	type eventSettings EventSettings
+	return unmarshalRaw(data, (*eventSettings)(e), &e.RawFields)
+}
+
+func (e EventSettings) MarshalJSON() ([]byte, error) {
+	type eventSettings EventSettings
+	return marshalRaw(eventSettings(e), e.RawFields)
+}
+
+func (f *FeeRate) UnmarshalJSON(data []byte) error {
+	type feeRate FeeRate
+	return unmarshalRaw(data, (*feeRate)(f), &f.RawFields)
+}
+
+func (f FeeRate) MarshalJSON() ([]byte, error) {
+	type feeRate FeeRate
+	return marshalRaw(feeRate(f), f.RawFields)
+}
+
+func (f *FeeResponse) UnmarshalJSON(data []byte) error {
+	type feeResponse FeeResponse
+	return unmarshalRaw(data, (*feeResponse)(f), &f.RawFields)
+}
+
+func (f FeeResponse) MarshalJSON() ([]byte, error) {
+	type feeResponse FeeResponse
+	return marshalRaw(feeResponse(f), f.RawFields)
+}
+
+func (f *Format) UnmarshalJSON(data []byte) error {
+	type format Format
+	return unmarshalRaw(data, (*format)(f), &f.RawFields)
+}
+
+func (f Format) MarshalJSON() ([]byte, error) {
+	type format Format
+	return marshalRaw(format(f), f.RawFields)
+}
+
+func (f *FormatResult) UnmarshalJSON(data []byte) error {
+	type formatResult FormatResult
+	return unmarshalRaw(data, (*formatResult)(f), &f.RawFields)
+}
+
+func (f FormatResult) MarshalJSON() ([]byte, error) {
+	type formatResult FormatResult
+	return marshalRaw(formatResult(f), f.RawFields)
+}
+
+func (i *Image) UnmarshalJSON(data []byte) error {
+	type image Image
+	return unmarshalRaw(data, (*image)(i), &i.RawFields)
+}
+
+func (i Image) MarshalJSON() ([]byte, error) {
+	type image Image
+	return marshalRaw(image(i), i.RawFields)
+}
+
+func (m *Media) UnmarshalJSON(data []byte) error {
+	type media Media
+	return unmarshalRaw(data, (*media)(m), &m.RawFields)
+}
+
+func (m Media) MarshalJSON() ([]byte, error) {
+	type media Media
+	return marshalRaw(media(m), m.RawFields)
+}
+
+func (n *Notification) UnmarshalJSON(data []byte) error {
+	type notification Notification
+	return unmarshalRaw(data, (*notification)(n), &n.RawFields)
+}
+
+func (n Notification) MarshalJSON() ([]byte, error) {
+	type notification Notification
+	return marshalRaw(notification(n), n.RawFields)
+}
+
+func (n *NotificationsResult) UnmarshalJSON(data []byte) error {
+	type notificationsResult NotificationsResult
+	return unmarshalRaw(data, (*notificationsResult)(n), &n.RawFields)
+}
+
+func (n NotificationsResult) MarshalJSON() ([]byte, error) {
+	type notificationsResult NotificationsResult
+	return marshalRaw(notificationsResult(n), n.RawFields)
+}
+
+func (o *Order) UnmarshalJSON(data []byte) error {
+	type order Order
+	return unmarshalRaw(data, (*order)(o), &o.RawFields)
+}
+
+func (o Order) MarshalJSON() ([]byte, error) {
+	type order Order
+	return marshalRaw(order(o), o.RawFields)
+}
+
+func (o *Organizer) UnmarshalJSON(data []byte) error {
+	type organizer Organizer
+	return unmarshalRaw(data, (*organizer)(o), &o.RawFields)
+}
+
+func (o Organizer) MarshalJSON() ([]byte, error) {
+	type organizer Organizer
+	return marshalRaw(organizer(o), o.RawFields)
+}
+
+func (o *OrganizerEventsResult) UnmarshalJSON(data []byte) error {
+	type organizerEventsResult OrganizerEventsResult
+	return unmarshalRaw(data, (*organizerEventsResult)(o), &o.RawFields)
+}
+
+func (o OrganizerEventsResult) MarshalJSON() ([]byte, error) {
+	type organizerEventsResult OrganizerEventsResult
+	return marshalRaw(organizerEventsResult(o), o.RawFields)
+}
+
+func (p *PayoutSettings) UnmarshalJSON(data []byte) error {
+	type payoutSettings PayoutSettings
+	return unmarshalRaw(data, (*payoutSettings)(p), &p.RawFields)
+}
+
+func (p PayoutSettings) MarshalJSON() ([]byte, error) {
+	type payoutSettings PayoutSettings
+	return marshalRaw(payoutSettings(p), p.RawFields)
+}
+
+func (p *PublishResult) UnmarshalJSON(data []byte) error {
+	type publishResult PublishResult
+	return unmarshalRaw(data, (*publishResult)(p), &p.RawFields)
+}
+
+func (p PublishResult) MarshalJSON() ([]byte, error) {
+	type publishResult PublishResult
+	return marshalRaw(publishResult(p), p.RawFields)
+}
+
+func (q *Question) UnmarshalJSON(data []byte) error {
+	type question Question
+	return unmarshalRaw(data, (*question)(q), &q.RawFields)
+}
+
+func (q Question) MarshalJSON() ([]byte, error) {
+	type question Question
+	return marshalRaw(question(q), q.RawFields)
+}
+
+func (q *QuestionsResult) UnmarshalJSON(data []byte) error {
+	type questionsResult QuestionsResult
+	return unmarshalRaw(data, (*questionsResult)(q), &q.RawFields)
+}
+
+func (q QuestionsResult) MarshalJSON() ([]byte, error) {
+	type questionsResult QuestionsResult
+	return marshalRaw(questionsResult(q), q.RawFields)
+}
+
+func (r *RefundRequest) UnmarshalJSON(data []byte) error {
+	type refundRequest RefundRequest
+	return unmarshalRaw(data, (*refundRequest)(r), &r.RawFields)
+}
+
+func (r RefundRequest) MarshalJSON() ([]byte, error) {
+	type refundRequest RefundRequest
+	return marshalRaw(refundRequest(r), r.RawFields)
+}
+
+func (r *Region) UnmarshalJSON(data []byte) error {
+	type region Region
+	return unmarshalRaw(data, (*region)(r), &r.RawFields)
+}
+
+func (r Region) MarshalJSON() ([]byte, error) {
+	type region Region
+	return marshalRaw(region(r), r.RawFields)
+}
+
+func (r *Regions) UnmarshalJSON(data []byte) error {
+	type regions Regions
+	return unmarshalRaw(data, (*regions)(r), &r.RawFields)
+}
+
+func (r Regions) MarshalJSON() ([]byte, error) {
+	type regions Regions
+	return marshalRaw(regions(r), r.RawFields)
+}
+
+func (r *Report) UnmarshalJSON(data []byte) error {
+	type report Report
+	return unmarshalRaw(data, (*report)(r), &r.RawFields)
+}
+
+func (r Report) MarshalJSON() ([]byte, error) {
+	type report Report
+	return marshalRaw(report(r), r.RawFields)
+}
+
+func (s *SeriesEventsResult) UnmarshalJSON(data []byte) error {
+	type seriesEventsResult SeriesEventsResult
+	return unmarshalRaw(data, (*seriesEventsResult)(s), &s.RawFields)
+}
+
+func (s SeriesEventsResult) MarshalJSON() ([]byte, error) {
+	type seriesEventsResult SeriesEventsResult
+	return marshalRaw(seriesEventsResult(s), s.RawFields)
+}
+
+func (s *SubCategoriesResult) UnmarshalJSON(data []byte) error {
+	type subCategoriesResult SubCategoriesResult
+	return unmarshalRaw(data, (*subCategoriesResult)(s), &s.RawFields)
+}
+
+func (s SubCategoriesResult) MarshalJSON() ([]byte, error) {
+	type subCategoriesResult SubCategoriesResult
+	return marshalRaw(subCategoriesResult(s), s.RawFields)
+}
+
+func (s *SubCategory) UnmarshalJSON(data []byte) error {
+	type subCategory SubCategory
+	return unmarshalRaw(data, (*subCategory)(s), &s.RawFields)
+}
+
+func (s SubCategory) MarshalJSON() ([]byte, error) {
+	type subCategory SubCategory
+	return marshalRaw(subCategory(s), s.RawFields)
+}
+
+func (t *TicketClass) UnmarshalJSON(data []byte) error {
+	type ticketClass TicketClass
+	return unmarshalRaw(data, (*ticketClass)(t), &t.RawFields)
+}
+
+func (t TicketClass) MarshalJSON() ([]byte, error) {
+	type ticketClass TicketClass
+	return marshalRaw(ticketClass(t), t.RawFields)
+}
+
+func (t *TicketGroup) UnmarshalJSON(data []byte) error {
+	type ticketGroup TicketGroup
+	return unmarshalRaw(data, (*ticketGroup)(t), &t.RawFields)
+}
+
+func (t TicketGroup) MarshalJSON() ([]byte, error) {
+	type ticketGroup TicketGroup
+	return marshalRaw(ticketGroup(t), t.RawFields)
+}
+
+func (t *Timezone) UnmarshalJSON(data []byte) error {
+	type timezone Timezone
+	return unmarshalRaw(data, (*timezone)(t), &t.RawFields)
+}
+
+func (t Timezone) MarshalJSON() ([]byte, error) {
+	type timezone Timezone
+	return marshalRaw(timezone(t), t.RawFields)
+}
+
+func (t *Timezones) UnmarshalJSON(data []byte) error {
+	type timezones Timezones
+	return unmarshalRaw(data, (*timezones)(t), &t.RawFields)
+}
+
+func (t Timezones) MarshalJSON() ([]byte, error) {
+	type timezones Timezones
+	return marshalRaw(timezones(t), t.RawFields)
+}
+
+func (t *TrackingBeacon) UnmarshalJSON(data []byte) error {
+	type trackingBeacon TrackingBeacon
+	return unmarshalRaw(data, (*trackingBeacon)(t), &t.RawFields)
+}
+
+func (t TrackingBeacon) MarshalJSON() ([]byte, error) {
+	type trackingBeacon TrackingBeacon
+	return marshalRaw(trackingBeacon(t), t.RawFields)
+}
+
+func (u *UnpublishResult) UnmarshalJSON(data []byte) error {
+	type unpublishResult UnpublishResult
+	return unmarshalRaw(data, (*unpublishResult)(u), &u.RawFields)
+}
+
+func (u UnpublishResult) MarshalJSON() ([]byte, error) {
+	type unpublishResult UnpublishResult
+	return marshalRaw(unpublishResult(u), u.RawFields)
+}
+
+func (u *User) UnmarshalJSON(data []byte) error {
+	type user User
+	return unmarshalRaw(data, (*user)(u), &u.RawFields)
+}
+
+func (u User) MarshalJSON() ([]byte, error) {
+	type user User
+	return marshalRaw(user(u), u.RawFields)
+}
+
+func (u *UserBookmarksResponse) UnmarshalJSON(data []byte) error {
+	type userBookmarksResponse UserBookmarksResponse
+	return unmarshalRaw(data, (*userBookmarksResponse)(u), &u.RawFields)
+}
+
+func (u UserBookmarksResponse) MarshalJSON() ([]byte, error) {
+	type userBookmarksResponse UserBookmarksResponse
+	return marshalRaw(userBookmarksResponse(u), u.RawFields)
+}
+
+func (u *UserContactListContacts) UnmarshalJSON(data []byte) error {
+	type userContactListContacts UserContactListContacts
+	return unmarshalRaw(data, (*userContactListContacts)(u), &u.RawFields)
+}
+
+func (u UserContactListContacts) MarshalJSON() ([]byte, error) {
+	type userContactListContacts UserContactListContacts
+	return marshalRaw(userContactListContacts(u), u.RawFields)
+}
+
+func (u *UserContactListsResponse) UnmarshalJSON(data []byte) error {
+	type userContactListsResponse UserContactListsResponse
+	return unmarshalRaw(data, (*userContactListsResponse)(u), &u.RawFields)
+}
+
+func (u UserContactListsResponse) MarshalJSON() ([]byte, error) {
+	type userContactListsResponse UserContactListsResponse
+	return marshalRaw(userContactListsResponse(u), u.RawFields)
+}
+
+func (u *UserEventAttendeesResponse) UnmarshalJSON(data []byte) error {
+	type userEventAttendeesResponse UserEventAttendeesResponse
+	return unmarshalRaw(data, (*userEventAttendeesResponse)(u), &u.RawFields)
+}
+
+func (u UserEventAttendeesResponse) MarshalJSON() ([]byte, error) {
+	type userEventAttendeesResponse UserEventAttendeesResponse
+	return marshalRaw(userEventAttendeesResponse(u), u.RawFields)
+}
+
+func (u *UserEventOrdersResponse) UnmarshalJSON(data []byte) error {
+	type userEventOrdersResponse UserEventOrdersResponse
+	return unmarshalRaw(data, (*userEventOrdersResponse)(u), &u.RawFields)
+}
+
+func (u UserEventOrdersResponse) MarshalJSON() ([]byte, error) {
+	type userEventOrdersResponse UserEventOrdersResponse
+	return marshalRaw(userEventOrdersResponse(u), u.RawFields)
+}
+
+func (u *UserEventsResponse) UnmarshalJSON(data []byte) error {
+	type userEventsResponse UserEventsResponse
+	return unmarshalRaw(data, (*userEventsResponse)(u), &u.RawFields)
+}
+
+func (u UserEventsResponse) MarshalJSON() ([]byte, error) {
+	type userEventsResponse UserEventsResponse
+	return marshalRaw(userEventsResponse(u), u.RawFields)
+}
+
+func (u *UserOrdersResult) UnmarshalJSON(data []byte) error {
+	type userOrdersResult UserOrdersResult
+	return unmarshalRaw(data, (*userOrdersResult)(u), &u.RawFields)
+}
+
+func (u UserOrdersResult) MarshalJSON() ([]byte, error) {
+	type userOrdersResult UserOrdersResult
+	return marshalRaw(userOrdersResult(u), u.RawFields)
+}
+
+func (u *UserOrganizerResponse) UnmarshalJSON(data []byte) error {
+	type userOrganizerResponse UserOrganizerResponse
+	return unmarshalRaw(data, (*userOrganizerResponse)(u), &u.RawFields)
+}
+
+func (u UserOrganizerResponse) MarshalJSON() ([]byte, error) {
+	type userOrganizerResponse UserOrganizerResponse
+	return marshalRaw(userOrganizerResponse(u), u.RawFields)
+}
+
+func (u *UserOwnedEventResponse) UnmarshalJSON(data []byte) error {
+	type userOwnedEventResponse UserOwnedEventResponse
+	return unmarshalRaw(data, (*userOwnedEventResponse)(u), &u.RawFields)
+}
+
+func (u UserOwnedEventResponse) MarshalJSON() ([]byte, error) {
+	type userOwnedEventResponse UserOwnedEventResponse
+	return marshalRaw(userOwnedEventResponse(u), u.RawFields)
+}
+
+func (u *UserTicketGroupResponse) UnmarshalJSON(data []byte) error {
+	type userTicketGroupResponse UserTicketGroupResponse
+	return unmarshalRaw(data, (*userTicketGroupResponse)(u), &u.RawFields)
+}
+
+func (u UserTicketGroupResponse) MarshalJSON() ([]byte, error) {
+	type userTicketGroupResponse UserTicketGroupResponse
+	return marshalRaw(userTicketGroupResponse(u), u.RawFields)
+}
+
+func (u *UserVenuesResponse) UnmarshalJSON(data []byte) error {
+	type userVenuesResponse UserVenuesResponse
+	return unmarshalRaw(data, (*userVenuesResponse)(u), &u.RawFields)
+}
+
+func (u UserVenuesResponse) MarshalJSON() ([]byte, error) {
+	type userVenuesResponse UserVenuesResponse
+	return marshalRaw(userVenuesResponse(u), u.RawFields)
+}
+
+func (v *Venue) UnmarshalJSON(data []byte) error {
+	type venue Venue
+	return unmarshalRaw(data, (*venue)(v), &v.RawFields)
+}
+
+func (v Venue) MarshalJSON() ([]byte, error) {
+	type venue Venue
+	return marshalRaw(venue(v), v.RawFields)
+}
+
+func (v *VenueEventsResult) UnmarshalJSON(data []byte) error {
+	type venueEventsResult VenueEventsResult
+	return unmarshalRaw(data, (*venueEventsResult)(v), &v.RawFields)
+}
+
+func (v VenueEventsResult) MarshalJSON() ([]byte, error) {
+	type venueEventsResult VenueEventsResult
+	return marshalRaw(venueEventsResult(v), v.RawFields)
+}
+
+func (w *Webhook) UnmarshalJSON(data []byte) error {
+	type webhook Webhook
+	return unmarshalRaw(data, (*webhook)(w), &w.RawFields)
+}
+
+func (w Webhook) MarshalJSON() ([]byte, error) {
+	type webhook Webhook
+	return marshalRaw(webhook(w), w.RawFields)
+}
+
+func (w *WebhooksResult) UnmarshalJSON(data []byte) error {
+	type webhooksResult WebhooksResult
+	return unmarshalRaw(data, (*webhooksResult)(w), &w.RawFields)
+}
+
+func (w WebhooksResult) MarshalJSON() ([]byte, error) {
+	type webhooksResult WebhooksResult
+	return marshalRaw(webhooksResult(w), w.RawFields)
+}
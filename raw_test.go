@@ -0,0 +1,197 @@
+package eventbrite
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalRaw(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		wantRaw   bool
+		wantExtra map[string]string
+	}{
+		{"known fields", `{"name": "Hall"}`, true, nil},
+		{"unknown fields", `{"name": "Hall", "capacity": 100, "future": {"a": 1}}`, true,
+			map[string]string{"capacity": `100`, "future": `{"a": 1}`}},
+		{"known fields in another case", `{"Name": "Hall", "ADDRESS": {}}`, true, nil},
+		{"null", `null`, false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v Venue
+			if err := json.Unmarshal([]byte(tt.in), &v); err != nil {
+				t.Fatal(err)
+			}
+			if got := v.Raw() != nil; got != tt.wantRaw {
+				t.Errorf("got raw %s", v.Raw())
+			} else if tt.wantRaw && string(v.Raw()) != tt.in {
+				t.Errorf("got raw %s, want %s", v.Raw(), tt.in)
+			}
+			extra := map[string]string{}
+			for name, f := range v.Extra() {
+				extra[name] = string(f)
+			}
+			if len(extra) != len(tt.wantExtra) || (len(extra) > 0 && !reflect.DeepEqual(extra, tt.wantExtra)) {
+				t.Errorf("got extra %v, want %v", extra, tt.wantExtra)
+			}
+		})
+	}
+}
+
+func TestUnmarshalRawNested(t *testing.T) {
+	in := `{"events": [{"id": "1", "venue": {"name": "Hall", "future": "x"}, "listed": true}], "location": {}}`
+	var r EventSearchResult
+	if err := json.Unmarshal([]byte(in), &r); err != nil {
+		t.Fatal(err)
+	}
+
+	extras := []struct {
+		name string
+		obj  RawFields
+		want string
+	}{
+		{"result", r.RawFields, "location"},
+		{"event", r.Events[0].RawFields, "listed"},
+		{"venue", r.Events[0].Venue.RawFields, "future"},
+	}
+	for _, e := range extras {
+		if _, ok := e.obj.Extra()[e.want]; !ok || len(e.obj.Extra()) != 1 {
+			t.Errorf("%s: got extra %v, want %s only", e.name, e.obj.Extra(), e.want)
+		}
+	}
+	if r.Events[0].Id != "1" || r.Events[0].Venue.Name != "Hall" {
+		t.Errorf("got event %+v", r.Events[0])
+	}
+}
+
+func TestMarshalRaw(t *testing.T) {
+	large := `{"name": "Hall", "notes": "` + strings.Repeat("x", 32<<10) + `"}`
+
+	tests := []struct {
+		name   string
+		in     string
+		modify func(v *Venue)
+		want   string
+	}{
+		// encoding/json compacts the original JSON, whose fields keep their order
+		{"unchanged", `{"name": "Hall", "future": "x"}`, nil, `{"name":"Hall","future":"x"}`},
+		{"modified", `{"name": "Hall", "future": "x"}`, func(v *Venue) { v.Name = "Room" },
+			`{"address":{},"future":"x","name":"Room"}`},
+		{"modified without unknown fields", `{"name": "Hall"}`, func(v *Venue) { v.Name = "Room" },
+			`{"name":"Room","address":{}}`},
+		{"unknown field shadowed", `{"name": "Hall", "address": {"city": "Paris"}}`, func(v *Venue) { v.Address.City = "Lyon" },
+			`{"name":"Hall","address":{"city":"Lyon"}}`},
+		{"large unchanged", large, nil, `{"name":"Hall","notes":"` + strings.Repeat("x", 32<<10) + `"}`},
+		{"not decoded", "", func(v *Venue) { v.Name = "Room" }, `{"name":"Room","address":{}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v Venue
+			if tt.in != "" {
+				if err := json.Unmarshal([]byte(tt.in), &v); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.modify != nil {
+				tt.modify(&v)
+			}
+
+			// through a value and a pointer
+			for _, obj := range []interface{}{v, &v} {
+				got, err := json.Marshal(obj)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(got) != tt.want {
+					t.Errorf("got  %s\nwant %s", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestMarshalRawLarge(t *testing.T) {
+	events := make([]string, 151)
+	for i := range events {
+		events[i] = fmt.Sprintf(`{"id":"%d","name":{"text":"Event %d","html":"Event %d"},"description":{"text":"%s"}}`,
+			i, i, i, strings.Repeat("x", 100))
+	}
+	in := `{"pagination":{"object_count":151,"page_number":1,"page_size":151,"page_count":1,"has_more_items":false},"events":[` +
+		strings.Join(events, ",") + `]}`
+
+	var r EventSearchResult
+	if err := json.Unmarshal([]byte(in), &r); err != nil {
+		t.Fatal(err)
+	}
+	got, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != in {
+		t.Errorf("got a %d bytes encoding of the %d bytes result, want the original", len(got), len(in))
+	}
+}
+
+func TestMarshalRawNested(t *testing.T) {
+	var e Event
+	if err := json.Unmarshal([]byte(`{"id": "1", "venue": {"name": "Hall", "future": "x"}, "listed": true}`), &e); err != nil {
+		t.Fatal(err)
+	}
+	e.Venue.Name = "Room"
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Event
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Venue.Name != "Room" {
+		t.Errorf("got venue %q, want the modified one", got.Venue.Name)
+	}
+	if _, ok := got.Extra()["listed"]; !ok {
+		t.Errorf("lost the unknown field of the event: %s", data)
+	}
+	if _, ok := got.Venue.Extra()["future"]; !ok {
+		t.Errorf("lost the unknown field of the venue: %s", data)
+	}
+}
+
+func TestRawFieldsField(t *testing.T) {
+	var v Venue
+	if err := json.Unmarshal([]byte(`{"name": "Hall", "capacity": 100}`), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		obj     Venue
+		field   string
+		wantOK  bool
+		wantErr bool
+		want    int
+	}{
+		{"unknown field", v, "capacity", true, false, 100},
+		{"missing field", v, "seats", false, false, 0},
+		{"wrong type", v, "name", true, true, 0},
+		{"not decoded", Venue{}, "capacity", false, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got int
+			ok, err := tt.obj.Field(tt.field, &got)
+			if ok != tt.wantOK || (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("got %d, %t, %v, want %d, %t and an error %t", got, ok, err, tt.want, tt.wantOK, tt.wantErr)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ import "golang.org/x/net/context"
 //
 // https://www.eventbrite.com/developer/v3/response_formats/order/#ebapi-std:format-refund-request
 type RefundRequest struct {
+	RawFields
+
 	// The email used to create the refund request
 	FromEmail string `json:"from_email"`
 	// The name used to create the refund request
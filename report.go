@@ -1,87 +1,125 @@
 package eventbrite
 
-import "golang.org/x/net/context"
+import (
+	"encoding/json"
+
+	"golang.org/x/net/context"
+)
 
 // https://www.eventbrite.com/developer/v3/endpoints/reports/#ebapi-parameters
 type ReportRequest struct {
 	// List of public event IDs to report on
-	EventIds []interface{} `json:"event_ids"`
+	EventIds []interface{} `json:"event_ids,omitempty"`
 	// Event status to filter down results by (Valid choices are: all, live, or ended)
-	EventStatus string `json:"event_status"`
+	EventStatus string `json:"event_status,omitempty"`
 	// Optional start date to query
-	StartDate string `json:"start_date"`
+	StartDate string `json:"start_date,omitempty"`
 	// Optional end date to query
-	EndDate string `json:"end_date"`
+	EndDate string `json:"end_date,omitempty"`
 	// Time period to provide aggregation for in units of the selected date_facet.
 	// For example, if date_facet=hour, then period=3 returns 3 hours worth of data
 	// from the current time in the event timezone. Day is the default choice if no date_facet
-	Period int `json:"period"`
+	Period int `json:"period,omitempty"`
 	// Optional filters for sales/attendees data formatted as: {“ticket_ids”: [1234, 5678],
 	// “currencies”: [“USD”],...}NOTE: currently only filter_by ticket_ids and one currency are supported.
 	//
 	// https://www.eventbrite.com/developer/v3/response_formats/basic/#ebapi-dictionary
-	FilterBy interface{} `json:"filter_by"`
+	FilterBy interface{} `json:"filter_by,omitempty"`
 	// Optional field to group data on (Valid choices are: payment_method, payment_method_application,
 	// ticket, ticket_application, currency, event_currency, reserved_section, event, event_ticket,
 	// event_application, country, city, state, source, zone, location, access_level, device_name,
 	// sales_channel_lvl_1, sales_channel_lvl_2, or sales_channel_lvl_3)
-	GroupBy string `json:"group_by"`
+	GroupBy string `json:"group_by,omitempty"`
 	// Optional date aggregation level to return data for. Day is the default choice. Monthly aggregation
 	// is represented by the first of the month. Weekly aggregation is represented by the ending Sunday of
 	// the week, where a week is defined as Monday-Sunday. (Valid choices are: fifteen, hour, day, event_day,
 	// week, month, year, or none)
-	DateFacet string `json:"date_facet"`
+	DateFacet string `json:"date_facet,omitempty"`
 	// Optional timezone. If unspecified picks the TZ of the first event
-	Timezone string `json:"timezone"`
+	Timezone string `json:"timezone,omitempty"`
 }
 
 // https://www.eventbrite.com/developer/v3/endpoints/reports/#ebapi-id1
 type ReportAttendees struct {
 	// List of public event IDs to report on
-	EventIds []interface{} `json:"event_ids"`
+	EventIds []interface{} `json:"event_ids,omitempty"`
 	// Event status to filter down results by (Valid choices are: all, live, or ended)
-	EventStatus string `json:"event_status"`
+	EventStatus string `json:"event_status,omitempty"`
 	// Optional start date to query
-	StartDate string `json:"start_date"`
+	StartDate string `json:"start_date,omitempty"`
 	// Optional end date to query
-	EndDate string `json:"end_date"`
+	EndDate string `json:"end_date,omitempty"`
 	// Time period to provide aggregation for in units of the selected date_facet.
 	// For example, if date_facet=hour, then period=3 returns 3 hours worth of data
 	// from the current time in the event timezone. Day is the default choice if no date_facet
-	Period int `json:"period"`
+	Period int `json:"period,omitempty"`
 	// Optional filters for sales/attendees data formatted as: {“ticket_ids”: [1234, 5678],
 	// “currencies”: [“USD”],...}NOTE: currently only filter_by ticket_ids and one currency are supported.
 	//
 	// https://www.eventbrite.com/developer/v3/response_formats/basic/#ebapi-dictionary
-	FilterBy interface{} `json:"filter_by"`
+	FilterBy interface{} `json:"filter_by,omitempty"`
 	// Optional field to group data on (Valid choices are: payment_method, payment_method_application,
 	// ticket, ticket_application, currency, event_currency, reserved_section, event, event_ticket,
 	// event_application, country, city, state, source, zone, location, access_level, device_name,
 	// sales_channel_lvl_1, sales_channel_lvl_2, or sales_channel_lvl_3)
-	GroupBy string `json:"group_by"`
+	GroupBy string `json:"group_by,omitempty"`
 	// Optional date aggregation level to return data for. Day is the default choice. Monthly aggregation
 	// is represented by the first of the month. Weekly aggregation is represented by the ending Sunday of
 	// the week, where a week is defined as Monday-Sunday. (Valid choices are: fifteen, hour, day, event_day,
 	// week, month, year, or none)
-	DateFacet string `json:"date_facet"`
+	DateFacet string `json:"date_facet,omitempty"`
 	// Optional timezone. If unspecified picks the TZ of the first event
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Report is the response structure of the sales and attendees reports
+//
+// https://www.eventbrite.com/developer/v3/endpoints/reports/
+type Report struct {
+	RawFields
+
+	// The timezone of the dates of the report
 	Timezone string `json:"timezone"`
+	// The events reported on
+	EventIDs []string `json:"event_ids"`
+	// The totals of the whole period
+	Totals ReportTotals `json:"totals"`
+	// The totals of each date_facet of the period
+	Data []ReportData `json:"data"`
+}
+
+// ReportData holds the totals of a date of a report
+type ReportData struct {
+	Date          string       `json:"date"`
+	DateLocalized string       `json:"date_localized"`
+	Totals        ReportTotals `json:"totals"`
+}
+
+// ReportTotals holds the aggregated figures of a report. The amounts are decimal numbers in
+// the currency of the report.
+type ReportTotals struct {
+	Currency     string      `json:"currency"`
+	Gross        json.Number `json:"gross"`
+	Net          json.Number `json:"net"`
+	Fees         json.Number `json:"fees"`
+	Quantity     int         `json:"quantity"`
+	NumAttendees int         `json:"num_attendees"`
 }
 
 // ReportSales returns a response of the aggregate sales data
 //
 // https://www.eventbrite.com/developer/v3/endpoints/reports/#ebapi-get-reports-sales
-func (c *Client) ReportSales(ctx context.Context, req *ReportRequest) (interface{}, error) {
-	var v interface{}
+func (c *Client) ReportSales(ctx context.Context, req *ReportRequest) (*Report, error) {
+	v := new(Report)
 
-	return v, c.getJSON(ctx, "/reports/sales/", req, &v)
+	return v, c.getJSON(ctx, "/reports/sales/", req, v)
 }
 
 // ReportSales returns a response of the aggregate attendees data
 //
 // https://www.eventbrite.com/developer/v3/endpoints/reports/#ebapi-get-reports-attendees
-func (c *Client) ReportAttendees(ctx context.Context, req *ReportAttendees) (interface{}, error) {
-	var v interface{}
+func (c *Client) ReportAttendees(ctx context.Context, req *ReportAttendees) (*Report, error) {
+	v := new(Report)
 
-	return v, c.getJSON(ctx, "/reports/attendees/", req, &v)
+	return v, c.getJSON(ctx, "/reports/attendees/", req, v)
 }
@@ -0,0 +1,132 @@
+package eventbrite
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// DefaultRetryPolicy is the retry policy of a client created without WithRetryPolicy. It retries
+// GET and DELETE requests up to three times, POST requests are never retried.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     30 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+	MaxElapsedTime: 2 * time.Minute,
+}
+
+// RetryPolicy controls how a failed request is retried. Requests are retried on network errors,
+// on HTTP 429 and on the 500, 502, 503 and 504 statuses. The wait between attempts grows
+// exponentially; a Retry-After header sent by the API takes precedence over the backoff.
+type RetryPolicy struct {
+	// The maximum number of attempts, including the first one. A value below 2 disables retries
+	MaxAttempts int
+	// The wait before the first retry
+	InitialBackoff time.Duration
+	// The upper bound of a single wait
+	MaxBackoff time.Duration
+	// The factor the wait grows by after each attempt
+	Multiplier float64
+	// The randomized fraction of the wait, between 0 and 1
+	Jitter float64
+	// The upper bound of the time spent on a request including all its retries. Zero means no
+	// bound other than the deadline of the request context
+	MaxElapsedTime time.Duration
+	// POST requests are not idempotent and are only retried when set
+	RetryPost bool
+}
+
+// WithRetryPolicy configures how failed requests are retried. A zero RetryPolicy disables retries.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.retryPolicy = p
+		return nil
+	}
+}
+
+// next reports whether the request should be attempted again, and how long to wait before
+// the next attempt
+func (p RetryPolicy) next(ctx context.Context, method string, attempt int, elapsed time.Duration, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if method == http.MethodPost && !p.RetryPost {
+		return 0, false
+	}
+	if ctx.Err() != nil {
+		return 0, false
+	}
+	if err == nil && !retryableStatus(resp.StatusCode) {
+		return 0, false
+	}
+
+	wait := p.backoff(attempt)
+	if resp != nil {
+		if after, ok := retryAfter(resp.Header, time.Now()); ok {
+			wait = after
+		}
+	}
+
+	if p.MaxElapsedTime > 0 && elapsed+wait > p.MaxElapsedTime {
+		return 0, false
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+		return 0, false
+	}
+
+	return wait, true
+}
+
+// backoff returns the jittered exponential wait after the given attempt
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	wait := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		wait += wait * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(wait)
+}
+
+func retryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as an HTTP date
+func retryAfter(h http.Header, now time.Time) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,203 @@
+package eventbrite
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{10, time.Second},
+	}
+	for _, tt := range tests {
+		if got := p.backoff(tt.attempt); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := p.backoff(2); got < 100*time.Millisecond || got > 300*time.Millisecond {
+			t.Fatalf("backoff(2) with jitter = %v, want within [100ms, 300ms]", got)
+		}
+	}
+}
+
+func TestRetryPolicyNext(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 3, InitialBackoff: 100 * time.Millisecond, Multiplier: 2}
+	response := func(status int, retryAfter string) *http.Response {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}}
+		if retryAfter != "" {
+			resp.Header.Set("Retry-After", retryAfter)
+		}
+		return resp
+	}
+	netErr := errors.New("connection reset")
+
+	tests := []struct {
+		name      string
+		policy    RetryPolicy
+		method    string
+		attempt   int
+		elapsed   time.Duration
+		resp      *http.Response
+		err       error
+		wantRetry bool
+		wantWait  time.Duration
+	}{
+		{"success", p, http.MethodGet, 1, 0, response(200, ""), nil, false, 0},
+		{"client error", p, http.MethodGet, 1, 0, response(404, ""), nil, false, 0},
+		{"server error", p, http.MethodGet, 1, 0, response(503, ""), nil, true, 100 * time.Millisecond},
+		{"second retry", p, http.MethodGet, 2, 0, response(500, ""), nil, true, 200 * time.Millisecond},
+		{"attempts exhausted", p, http.MethodGet, 3, 0, response(500, ""), nil, false, 0},
+		{"network error", p, http.MethodDelete, 1, 0, nil, netErr, true, 100 * time.Millisecond},
+		{"rate limited", p, http.MethodGet, 1, 0, response(429, "7"), nil, true, 7 * time.Second},
+		{"post", p, http.MethodPost, 1, 0, response(503, ""), nil, false, 0},
+		{"post retried", RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second, RetryPost: true},
+			http.MethodPost, 1, 0, response(503, ""), nil, true, time.Second},
+		{"elapsed time exceeded", RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second, MaxElapsedTime: 5 * time.Second},
+			http.MethodGet, 1, 4500 * time.Millisecond, response(503, ""), nil, false, 0},
+		{"zero policy", RetryPolicy{}, http.MethodGet, 1, 0, response(503, ""), nil, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wait, retry := tt.policy.next(context.Background(), tt.method, tt.attempt, tt.elapsed, tt.resp, tt.err)
+			if retry != tt.wantRetry || wait != tt.wantWait {
+				t.Errorf("next() = %v, %v, want %v, %v", wait, retry, tt.wantWait, tt.wantRetry)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyNextContext(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second}
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, retry := p.next(ctx, http.MethodGet, 1, 0, resp, nil); retry {
+		t.Error("retried with a canceled context")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, retry := p.next(ctx, http.MethodGet, 1, 0, resp, nil); retry {
+		t.Error("retried past the context deadline")
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		header string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"", 0, false},
+		{"0", 0, true},
+		{"30", 30 * time.Second, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second, true},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+	}
+	for _, tt := range tests {
+		h := http.Header{}
+		if tt.header != "" {
+			h.Set("Retry-After", tt.header)
+		}
+		got, ok := retryAfter(h, now)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("retryAfter(%q) = %v, %v, want %v, %v", tt.header, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestClientRetries(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: 10 * time.Millisecond, Multiplier: 2}
+
+	t.Run("until success", func(t *testing.T) {
+		var attempts int32
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&attempts, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"categories": []}`))
+		}, WithRetryPolicy(policy))
+
+		if _, err := c.Categories(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if attempts != 3 {
+			t.Errorf("attempts = %d, want 3", attempts)
+		}
+	})
+
+	t.Run("gives up", func(t *testing.T) {
+		var attempts int32
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		}, WithRetryPolicy(policy))
+
+		_, err := c.Categories(context.Background())
+		if !errors.Is(err, ErrServer) {
+			t.Fatalf("err = %v, want ErrServer", err)
+		}
+		if attempts != 3 {
+			t.Errorf("attempts = %d, want 3", attempts)
+		}
+	})
+
+	t.Run("post not retried", func(t *testing.T) {
+		var attempts int32
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}, WithRetryPolicy(policy))
+
+		if _, err := c.EventPublish(context.Background(), "1"); err == nil {
+			t.Fatal("want an error")
+		}
+		if attempts != 1 {
+			t.Errorf("attempts = %d, want 1", attempts)
+		}
+	})
+
+	t.Run("honors Retry-After", func(t *testing.T) {
+		var attempts int32
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&attempts, 1) == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Write([]byte(`{"categories": []}`))
+		}, WithRetryPolicy(policy))
+
+		start := time.Now()
+		if _, err := c.Categories(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if elapsed := time.Since(start); elapsed < time.Second {
+			t.Errorf("retried after %v, want at least the 1s of Retry-After", elapsed)
+		}
+	})
+}
@@ -0,0 +1,235 @@
+package eventbrite
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Priority of a request in the queue in front of the rate limiter. Requests of a higher
+// priority are let through the rate limiter first; lower priorities get the leftover capacity.
+type Priority int
+
+const (
+	// PriorityLow is meant for bulk jobs such as exports and nightly syncs
+	PriorityLow Priority = iota
+	// PriorityNormal is the priority of requests made without WithPriority
+	PriorityNormal
+	// PriorityHigh is meant for interactive calls a user is waiting on
+	PriorityHigh
+
+	numPriorities = 3
+)
+
+// DefaultMaxQueueWait is the time after which a queued request is let through regardless
+// of its priority
+var DefaultMaxQueueWait = 30 * time.Second
+
+type priorityKey struct{}
+
+// WithPriority returns a context whose requests are queued with the given priority
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+func priorityFrom(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityKey{}).(Priority); ok && p >= PriorityLow && p <= PriorityHigh {
+		return p
+	}
+	return PriorityNormal
+}
+
+// WithMaxQueueWait configures the starvation protection of the request queue. A request waiting
+// longer than d is let through before any request of a higher priority. Default is DefaultMaxQueueWait.
+func WithMaxQueueWait(d time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.scheduler.maxWait = d
+		return nil
+	}
+}
+
+// LaneStats holds the queue metrics of one priority
+type LaneStats struct {
+	// Requests currently waiting in the queue
+	Queued int
+	// Requests let through the rate limiter so far
+	Requests int64
+	// Requests let through ahead of higher priorities because they waited too long
+	Promoted int64
+	// Time spent waiting for the queue and the rate limiter, summed over all requests
+	TotalWait time.Duration
+	// Longest time a single request waited
+	MaxWait time.Duration
+}
+
+// SchedulerStats returns the queue metrics of each priority, summed over the queues of the
+// client token and of the tokens given by WithRequestToken
+func (c *Client) SchedulerStats() map[Priority]LaneStats {
+	stats := c.scheduler.snapshot()
+
+	c.tokenLimitersMu.Lock()
+	defer c.tokenLimitersMu.Unlock()
+	for _, tl := range c.tokenLimiters {
+		for p, st := range tl.scheduler.snapshot() {
+			sum := stats[p]
+			sum.Queued += st.Queued
+			sum.Requests += st.Requests
+			sum.Promoted += st.Promoted
+			sum.TotalWait += st.TotalWait
+			if st.MaxWait > sum.MaxWait {
+				sum.MaxWait = st.MaxWait
+			}
+			stats[p] = sum
+		}
+	}
+	return stats
+}
+
+type ticket struct {
+	priority Priority
+	queued   time.Time
+	ready    chan struct{}
+	granted  bool
+}
+
+// scheduler lets one request at a time wait on the rate limiter, picking the next one from the
+// highest priority lane unless a lower lane request has waited longer than maxWait
+type scheduler struct {
+	mu      sync.Mutex
+	busy    bool
+	lanes   [numPriorities][]*ticket
+	stats   [numPriorities]LaneStats
+	maxWait time.Duration
+}
+
+func newScheduler() *scheduler {
+	return &scheduler{maxWait: DefaultMaxQueueWait}
+}
+
+// acquire blocks until it is the turn of the caller. Each successful acquire must be followed
+// by a release.
+func (s *scheduler) acquire(ctx context.Context, p Priority) error {
+	s.mu.Lock()
+	if !s.busy && s.queued() == 0 {
+		s.busy = true
+		s.mu.Unlock()
+		return nil
+	}
+	t := &ticket{priority: p, queued: time.Now(), ready: make(chan struct{})}
+	s.lanes[p] = append(s.lanes[p], t)
+	s.mu.Unlock()
+
+	select {
+	case <-t.ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+	if t.granted {
+		// the turn was handed over while giving up, pass it on
+		s.mu.Unlock()
+		s.release()
+		return ctx.Err()
+	}
+	lane := s.lanes[p]
+	for i := range lane {
+		if lane[i] == t {
+			s.lanes[p] = append(lane[:i], lane[i+1:]...)
+			break
+		}
+	}
+	s.mu.Unlock()
+	return ctx.Err()
+}
+
+// release hands the turn over to the next queued request
+func (s *scheduler) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, promoted := s.next(time.Now())
+	if t == nil {
+		s.busy = false
+		return
+	}
+	if promoted {
+		s.stats[t.priority].Promoted++
+	}
+	t.granted = true
+	close(t.ready)
+}
+
+// next removes and returns the request to serve: the longest waiting one if it exceeded
+// maxWait, otherwise the head of the highest priority lane
+func (s *scheduler) next(now time.Time) (*ticket, bool) {
+	if s.maxWait > 0 {
+		oldest := -1
+		for p := range s.lanes {
+			if len(s.lanes[p]) > 0 && now.Sub(s.lanes[p][0].queued) >= s.maxWait &&
+				(oldest < 0 || s.lanes[p][0].queued.Before(s.lanes[oldest][0].queued)) {
+				oldest = p
+			}
+		}
+		if oldest >= 0 {
+			return s.pop(oldest), s.higherQueued(Priority(oldest))
+		}
+	}
+
+	for p := numPriorities - 1; p >= 0; p-- {
+		if len(s.lanes[p]) > 0 {
+			return s.pop(p), false
+		}
+	}
+	return nil, false
+}
+
+func (s *scheduler) pop(p int) *ticket {
+	t := s.lanes[p][0]
+	s.lanes[p] = s.lanes[p][1:]
+	return t
+}
+
+func (s *scheduler) higherQueued(p Priority) bool {
+	for q := int(p) + 1; q < numPriorities; q++ {
+		if len(s.lanes[q]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *scheduler) queued() int {
+	n := 0
+	for p := range s.lanes {
+		n += len(s.lanes[p])
+	}
+	return n
+}
+
+// observe records the time a request of the given priority waited before being sent
+func (s *scheduler) observe(p Priority, wait time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := &s.stats[p]
+	st.Requests++
+	st.TotalWait += wait
+	if wait > st.MaxWait {
+		st.MaxWait = wait
+	}
+}
+
+func (s *scheduler) snapshot() map[Priority]LaneStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := make(map[Priority]LaneStats, numPriorities)
+	for p := range s.stats {
+		st := s.stats[p]
+		st.Queued = len(s.lanes[p])
+		stats[Priority(p)] = st
+	}
+	return stats
+}
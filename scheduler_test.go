@@ -0,0 +1,133 @@
+package eventbrite
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// waitQueued waits until n requests are queued in the scheduler
+func waitQueued(t *testing.T, s *scheduler, n int) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		s.mu.Lock()
+		queued := s.queued()
+		s.mu.Unlock()
+		if queued == n {
+			return
+		}
+	}
+	t.Fatalf("%d requests never got queued", n)
+}
+
+func TestSchedulerPriorityOrder(t *testing.T) {
+	s := newScheduler()
+	if err := s.acquire(context.Background(), PriorityNormal); err != nil {
+		t.Fatal(err)
+	}
+
+	order := make(chan Priority, 3)
+	for i, p := range []Priority{PriorityLow, PriorityNormal, PriorityHigh} {
+		go func(p Priority) {
+			if err := s.acquire(context.Background(), p); err != nil {
+				t.Error(err)
+			}
+			order <- p
+			s.release()
+		}(p)
+		waitQueued(t, s, i+1)
+	}
+
+	s.release()
+	for _, want := range []Priority{PriorityHigh, PriorityNormal, PriorityLow} {
+		if got := <-order; got != want {
+			t.Fatalf("served %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSchedulerStarvation(t *testing.T) {
+	s := newScheduler()
+	s.maxWait = time.Minute
+	now := time.Now()
+
+	low := &ticket{priority: PriorityLow, queued: now.Add(-2 * time.Minute)}
+	high := &ticket{priority: PriorityHigh, queued: now}
+	s.lanes[PriorityLow] = []*ticket{low}
+	s.lanes[PriorityHigh] = []*ticket{high}
+
+	if got, promoted := s.next(now); got != low || !promoted {
+		t.Errorf("next = %v, %v, want the starving low priority request, promoted", got, promoted)
+	}
+	if got, promoted := s.next(now); got != high || promoted {
+		t.Errorf("next = %v, %v, want the high priority request", got, promoted)
+	}
+	if got, _ := s.next(now); got != nil {
+		t.Errorf("next on empty lanes = %v, want nil", got)
+	}
+}
+
+func TestSchedulerCancel(t *testing.T) {
+	s := newScheduler()
+	s.acquire(context.Background(), PriorityNormal)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() { errc <- s.acquire(ctx, PriorityLow) }()
+	waitQueued(t, s, 1)
+
+	cancel()
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		t.Fatalf("acquire = %v, want context.Canceled", err)
+	}
+	waitQueued(t, s, 0)
+
+	// the turn is not lost to the canceled request
+	s.release()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := s.acquire(ctx, PriorityNormal); err != nil {
+		t.Fatalf("acquire after cancel: %v", err)
+	}
+}
+
+func TestPriorityFrom(t *testing.T) {
+	tests := []struct {
+		ctx  context.Context
+		want Priority
+	}{
+		{context.Background(), PriorityNormal},
+		{WithPriority(context.Background(), PriorityHigh), PriorityHigh},
+		{WithPriority(context.Background(), PriorityLow), PriorityLow},
+		{WithPriority(context.Background(), Priority(42)), PriorityNormal},
+	}
+	for _, tt := range tests {
+		if got := priorityFrom(tt.ctx); got != tt.want {
+			t.Errorf("priorityFrom() = %v, want %v", got, tt.want)
+		}
+	}
+}
+
+func TestClientSchedulerStats(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"categories": []}`))
+	})
+
+	c.Categories(WithPriority(context.Background(), PriorityHigh))
+	c.Categories(WithPriority(context.Background(), PriorityHigh))
+	c.Categories(context.Background())
+
+	stats := c.SchedulerStats()
+	if got := stats[PriorityHigh].Requests; got != 2 {
+		t.Errorf("high priority requests = %d, want 2", got)
+	}
+	if got := stats[PriorityNormal].Requests; got != 1 {
+		t.Errorf("normal priority requests = %d, want 1", got)
+	}
+	if got := stats[PriorityLow]; got != (LaneStats{}) {
+		t.Errorf("low priority stats = %+v, want none", got)
+	}
+}
@@ -0,0 +1,279 @@
+package eventbrite
+
+import "golang.org/x/net/context"
+
+//go:generate go run ./internal/servicegen
+
+// Services is the whole surface of the API, implemented by *Client. Code depending on a few
+// resources only should accept the service interfaces below instead, e.g. an EventService, so
+// it can be given a Mock of the eventbritetest package in tests, or a service wrapped with
+// Decorate.
+//
+// The methods are documented on Client. The iterators are not part of the interfaces.
+type Services interface {
+	CategoryService
+	FormatService
+	SystemService
+	EventService
+	SeriesService
+	OrderService
+	VenueService
+	OrganizerService
+	UserService
+	CheckoutService
+	DiscountService
+	TicketGroupService
+	MediaService
+	NotificationService
+	PricingService
+	RefundRequestService
+	ReportService
+	TrackingBeaconService
+	WebhookService
+}
+
+var _ Services = (*Client)(nil)
+
+// mutatingOperations are the operations of Services changing data, i.e. sending POST or DELETE
+// requests, as reported by Call.Mutating. The table is read by internal/servicegen, which
+// rejects the names that are not operations, and is kept in sync with the client by the tests.
+var mutatingOperations = map[string]bool{
+	"EventCreate":                true,
+	"EventUpdate":                true,
+	"EventPublish":               true,
+	"EventUnPublish":             true,
+	"EventCancel":                true,
+	"EventDelete":                true,
+	"EventUpdateDisplaySettings": true,
+
+	"EventCreateTicketClass":    true,
+	"EventUpdateTicketClass":    true,
+	"EventDeleteTicketClass":    true,
+	"EventCreateCannedQuestion": true,
+	"EventCreateQuestion":       true,
+
+	"EventSeriesCreate":    true,
+	"EventSeriesPublish":   true,
+	"EventSeriesUnPublish": true,
+	"EventSeriesCancel":    true,
+	"EventSeriesDelete":    true,
+	"EventSeriesCUD":       true,
+
+	"VenueUpdate": true,
+	"VenueCreate": true,
+
+	"OrganizerCreate": true,
+	"OrganizerUpdate": true,
+
+	"UserCreateContactList":         true,
+	"UserUpdateContactList":         true,
+	"UserDeleteContactList":         true,
+	"UserListContactAddContacts":    true,
+	"UserListContactDeleteContacts": true,
+
+	"UserSaveBookmarks":   true,
+	"UserUnSaveBookmarks": true,
+	"UserSetAssortments":  true,
+
+	"CheckoutCreate":                  true,
+	"CheckoutAssociate":               true,
+	"CheckoutAssociatePayoutSettings": true,
+
+	"DiscountCreate": true,
+	"DiscountUpdate": true,
+	"DiscountDelete": true,
+
+	"TicketGroupDelete": true,
+	"TicketGroupCreate": true,
+	"TicketGroupUpdate": true,
+
+	"RefundRequestUpdate": true,
+	"RefundRequestCreate": true,
+
+	"TrackingBeaconCreate": true,
+	"TrackingBeaconUpdate": true,
+	"TrackingBeaconDelete": true,
+
+	"WebhookDelete": true,
+	"WebhookCreate": true,
+}
+
+// CategoryService gives access to the categories and subcategories of events
+type CategoryService interface {
+	Categories(ctx context.Context) (*CategoriesResult, error)
+	Category(ctx context.Context, id string) (*Category, error)
+	SubCategories(ctx context.Context) (*SubCategoriesResult, error)
+	SubCategory(ctx context.Context, id string) (*SubCategory, error)
+}
+
+// FormatService gives access to the formats of events
+type FormatService interface {
+	Formats(ctx context.Context) (*FormatResult, error)
+	Format(ctx context.Context, id string) (*Format, error)
+}
+
+// SystemService gives access to the reference data of the API: timezones, regions and countries
+type SystemService interface {
+	Timezones(ctx context.Context) (*Timezones, error)
+	Regions(ctx context.Context) (*Regions, error)
+	Countries(ctx context.Context) (*Countries, error)
+}
+
+// EventService gives access to events, their ticket classes and their questions
+type EventService interface {
+	EventSearch(ctx context.Context, req *EventSearchRequest, expand ...Expansion) (*EventSearchResult, error)
+	EventGet(ctx context.Context, id string, expand ...Expansion) (*Event, error)
+	EventCreate(ctx context.Context, req *EventCreateRequest) (*Event, error)
+	EventUpdate(ctx context.Context, id string, req *EventUpdateRequest) (*Event, error)
+	EventPublish(ctx context.Context, id string) (*PublishResult, error)
+	EventUnPublish(ctx context.Context, id string) (*UnpublishResult, error)
+	EventCancel(ctx context.Context, id string) (*CancelResult, error)
+	EventDelete(ctx context.Context, id string) (*DeleteResult, error)
+	EventGetDisplaySettings(ctx context.Context, id string) (*EventSettings, error)
+	EventUpdateDisplaySettings(ctx context.Context, id string, settings *EventUpdateDisplaySettings) (*EventSettings, error)
+	EventGetTicketClasses(ctx context.Context, id string, class *EventGetTicketClass) (*EventGetTicketClassResult, error)
+	EventCreateTicketClass(ctx context.Context, id string, class *EventCreateTicketClass) (*TicketClass, error)
+	EventGetTicketClass(ctx context.Context, eventId, ticketId string) (*TicketClass, error)
+	EventUpdateTicketClass(ctx context.Context, eventId, ticketId string, class *EventUpdateTicketClass) (*TicketClass, error)
+	EventDeleteTicketClass(ctx context.Context, eventId, ticketId string, class *EventDeleteTicketClass) (*DeleteResult, error)
+	EventGetCannedQuestions(ctx context.Context, id string, q *EventGetCannedQuestions) (*QuestionsResult, error)
+	EventCreateCannedQuestion(ctx context.Context, id string, q *EventCreateCannedQuestion) (*Question, error)
+	EventGetQuestions(ctx context.Context, id string, q *EventGetQuestions) (*QuestionsResult, error)
+	EventCreateQuestion(ctx context.Context, id string, q *EventCreateQuestion) (*Question, error)
+	EventGetQuestion(ctx context.Context, eventId, questionId string) (*Question, error)
+}
+
+// SeriesService gives access to repeating event series
+type SeriesService interface {
+	EventSeriesCreate(ctx context.Context, req *SeriesCreateEventRequest) (*Series, error)
+	EventSeriesGet(ctx context.Context, id string) (*Series, error)
+	EventSeriesEvents(ctx context.Context, id string, req *SeriesEventRequest) (*SeriesEventsResult, error)
+	EventSeriesPublish(ctx context.Context, id string) (*PublishResult, error)
+	EventSeriesUnPublish(ctx context.Context, id string) (*UnpublishResult, error)
+	EventSeriesCancel(ctx context.Context, id string) (*CancelResult, error)
+	EventSeriesDelete(ctx context.Context, id string) (*DeleteResult, error)
+	EventSeriesCUD(ctx context.Context, id string, req *SeriesCUREventRequest) (*SeriesEventsResult, error)
+}
+
+// OrderService gives access to orders
+type OrderService interface {
+	OrderGet(ctx context.Context, id string, expand ...Expansion) (*Order, error)
+}
+
+// VenueService gives access to venues
+type VenueService interface {
+	VenueGet(ctx context.Context, id string) (*Venue, error)
+	VenueUpdate(ctx context.Context, id string, req *UpdateVenueRequest) (*Venue, error)
+	VenueCreate(ctx context.Context, req *CreateVenueRequest) (*Venue, error)
+	VenueEvents(ctx context.Context, venueId string, expand ...Expansion) (*VenueEventsResult, error)
+}
+
+// OrganizerService gives access to organizers
+type OrganizerService interface {
+	OrganizerCreate(ctx context.Context, req *CreateOrganizerRequest) (*Organizer, error)
+	OrganizerGet(ctx context.Context, id string) (*Organizer, error)
+	OrganizerUpdate(ctx context.Context, id string, req *UpdateOrganizerRequest) (*Organizer, error)
+	OrganizerGetEvents(ctx context.Context, id string, req *OrganizerEventsRequest, expand ...Expansion) (*OrganizerEventsResult, error)
+}
+
+// UserService gives access to users, their events, orders, contact lists and bookmarks
+type UserService interface {
+	User(ctx context.Context, id string) (*User, error)
+	UserOrders(ctx context.Context, id string, req *UserEventOrders, expand ...Expansion) (*UserOrdersResult, error)
+	UserOrganizers(ctx context.Context, id string, req *UserOrganizerRequest) (*UserOrganizerResponse, error)
+	UserOwnedEvents(ctx context.Context, id string, req *UserOwnedEventsRequest, expand ...Expansion) (*UserOwnedEventResponse, error)
+	UserEvents(ctx context.Context, id string, req UserEventsRequest) (*UserEventsResponse, error)
+	UserVenues(ctx context.Context, id string) (*UserVenuesResponse, error)
+	UserEventAttendees(ctx context.Context, id string, request *UserEventAttendeesRequest, expand ...Expansion) (*UserEventAttendeesResponse, error)
+	UserEventOrders(ctx context.Context, id string, request *UserEventOrdersRequest, expand ...Expansion) (*UserEventOrdersResponse, error)
+	UserContactLists(ctx context.Context, id string) (*UserContactListsResponse, error)
+	UserCreateContactList(ctx context.Context, id string, request *UserCreateContactListsRequest) (*UserContactListsResponse, error)
+	UserContactList(ctx context.Context, id, contactListID string, request *UserCreateContactListsRequest) (*UserContactListsResponse, error)
+	UserUpdateContactList(ctx context.Context, id, contactListID string, request *UserUpdateContactListRequest) (*UserContactListsResponse, error)
+	UserDeleteContactList(ctx context.Context, id, contactListID string) (*DeleteResult, error)
+	UserListContactContacts(ctx context.Context, id, contactListID string) (*UserContactListContacts, error)
+	UserListContactAddContacts(ctx context.Context, id, contactListID string, req *UserAddContactListContactRequest) (*UserContactListContacts, error)
+	UserListContactDeleteContacts(ctx context.Context, id, contactListID string) (*DeleteResult, error)
+	UserBookmarks(ctx context.Context, id string, req *UserBookmarksRequest, expand ...Expansion) (*UserBookmarksResponse, error)
+	UserSaveBookmarks(ctx context.Context, id string, req *UserSaveBookmarkRequest) (*CreateResult, error)
+	UserUnSaveBookmarks(ctx context.Context, id string, req *UserUnSaveBookmarkRequest) (*DeleteResult, error)
+	UserAssortments(ctx context.Context, id string) (*Assortment, error)
+	UserSetAssortments(ctx context.Context, id string, req *UserSetAssortmentRequest) (*Assortment, error)
+	UserTicketGroups(ctx context.Context, id string, req *UserTicketGroupsRequest) (*UserTicketGroupResponse, error)
+}
+
+// CheckoutService gives access to checkout settings
+type CheckoutService interface {
+	CheckoutGetList(ctx context.Context) (*Checkout, error)
+	CheckoutMethods(ctx context.Context, req CheckoutMethodsRequest) (*CheckoutMethodsResponse, error)
+	CheckoutForAccount(ctx context.Context, req *CheckoutForAccountRequest) (*CheckoutSettingsForAccount, error)
+	CheckoutCreate(ctx context.Context, req *CheckoutCreateRequest) (*Checkout, error)
+	CheckoutGet(ctx context.Context, id string) (*Checkout, error)
+	CheckoutByEvent(ctx context.Context, eventId string) (*EventCheckoutSettings, error)
+	CheckoutAssociate(ctx context.Context, eventID string, req *CheckoutAssociateToEventRequest) (*EventCheckoutSettings, error)
+	CheckoutAssociatePayoutSettings(ctx context.Context, eventID string, req *CheckoutAssociatePayoutToEvent) (*PayoutSettings, error)
+}
+
+// DiscountService gives access to cross event discounts
+type DiscountService interface {
+	DiscountsGet(ctx context.Context, id string) (*CrossEventDiscount, error)
+	DiscountCreate(ctx context.Context, req *DiscountCreateRequest) (*CrossEventDiscount, error)
+	DiscountUpdate(ctx context.Context, id string, req *DiscountUpdateRequest) (*CrossEventDiscount, error)
+	DiscountDelete(ctx context.Context, id string) (*DeleteResult, error)
+}
+
+// TicketGroupService gives access to ticket groups
+type TicketGroupService interface {
+	TicketGroupGet(ctx context.Context, id string) (*TicketGroup, error)
+	TicketGroupDelete(ctx context.Context, id string) (*DeleteResult, error)
+	TicketGroupCreate(ctx context.Context, id string, req *CreateTicketGroupRequest) (*TicketGroup, error)
+	TicketGroupUpdate(ctx context.Context, id string, req *UpdateTicketGroupRequest) (*TicketGroup, error)
+}
+
+// MediaService gives access to media uploads
+type MediaService interface {
+	MediaGet(ctx context.Context, req *MediaGetUpload) (*Media, error)
+	MediaGetUpload(ctx context.Context, id string) (*Image, error)
+	MediaCreate(ctx context.Context, req *MediaCreateUpload) (*Image, error)
+}
+
+// NotificationService gives access to notifications
+type NotificationService interface {
+	Notifications(ctx context.Context) (*NotificationsResult, error)
+}
+
+// PricingService gives access to fee rates
+type PricingService interface {
+	FeeRate(ctx context.Context, req *FeeRequest) (*FeeResponse, error)
+}
+
+// RefundRequestService gives access to refund requests
+type RefundRequestService interface {
+	RefundRequest(ctx context.Context, id string) (*RefundRequest, error)
+	RefundRequestUpdate(ctx context.Context, id string, req *UpdateOrganizerRequest) (*RefundRequest, error)
+	RefundRequestCreate(ctx context.Context, req *CreateRefundRequest) (*RefundRequest, error)
+}
+
+// ReportService gives access to sales and attendees reports
+type ReportService interface {
+	ReportSales(ctx context.Context, req *ReportRequest) (*Report, error)
+	ReportAttendees(ctx context.Context, req *ReportAttendees) (*Report, error)
+}
+
+// TrackingBeaconService gives access to tracking beacons
+type TrackingBeaconService interface {
+	TrackingBeaconCreate(ctx context.Context, req *CreateTrackingBeaconRequest) (*TrackingBeacon, error)
+	TrackingBeaconGet(ctx context.Context, id string, req *GetTrackingBeaconRequest) (*TrackingBeacon, error)
+	TrackingBeaconUpdate(ctx context.Context, id string, req *UpdateTrackingBeaconRequest) (*TrackingBeacon, error)
+	TrackingBeaconDelete(ctx context.Context, id string) (*TrackingBeacon, error)
+	TrackingBeaconGetForEvent(ctx context.Context, eventId string, req *GetTrackingBeaconForEventRequest) (*TrackingBeacon, error)
+	TrackingBeaconGetForUser(ctx context.Context, userId string, req *GetTrackingBeaconForUserRequest) (*TrackingBeacon, error)
+}
+
+// WebhookService gives access to webhooks
+type WebhookService interface {
+	WebhookGet(ctx context.Context, id string) (*Webhook, error)
+	WebhookDelete(ctx context.Context, id string) (*Webhook, error)
+	Webhooks(ctx context.Context, req *WebhooksRequest) (*WebhooksResult, error)
+	WebhookCreate(ctx context.Context, req *CreateWebhookRequest) (*Webhook, error)
+}
@@ -0,0 +1,1180 @@
+// Code generated by internal/servicegen. DO NOT EDIT.
+
+package eventbrite
+
+import "golang.org/x/net/context"
+
+// Decorate wraps next with the interceptors, the first one being the outermost
+func Decorate(next Services, interceptors ...Interceptor) Services {
+	return struct {
+		CategoryService
+		FormatService
+		SystemService
+		EventService
+		SeriesService
+		OrderService
+		VenueService
+		OrganizerService
+		UserService
+		CheckoutService
+		DiscountService
+		TicketGroupService
+		MediaService
+		NotificationService
+		PricingService
+		RefundRequestService
+		ReportService
+		TrackingBeaconService
+		WebhookService
+	}{
+		DecorateCategoryService(next, interceptors...),
+		DecorateFormatService(next, interceptors...),
+		DecorateSystemService(next, interceptors...),
+		DecorateEventService(next, interceptors...),
+		DecorateSeriesService(next, interceptors...),
+		DecorateOrderService(next, interceptors...),
+		DecorateVenueService(next, interceptors...),
+		DecorateOrganizerService(next, interceptors...),
+		DecorateUserService(next, interceptors...),
+		DecorateCheckoutService(next, interceptors...),
+		DecorateDiscountService(next, interceptors...),
+		DecorateTicketGroupService(next, interceptors...),
+		DecorateMediaService(next, interceptors...),
+		DecorateNotificationService(next, interceptors...),
+		DecoratePricingService(next, interceptors...),
+		DecorateRefundRequestService(next, interceptors...),
+		DecorateReportService(next, interceptors...),
+		DecorateTrackingBeaconService(next, interceptors...),
+		DecorateWebhookService(next, interceptors...),
+	}
+}
+
+// operations are the names of the operations of Services
+var operations = map[string]bool{
+	"Categories":                      true,
+	"Category":                        true,
+	"SubCategories":                   true,
+	"SubCategory":                     true,
+	"Formats":                         true,
+	"Format":                          true,
+	"Timezones":                       true,
+	"Regions":                         true,
+	"Countries":                       true,
+	"EventSearch":                     true,
+	"EventGet":                        true,
+	"EventCreate":                     true,
+	"EventUpdate":                     true,
+	"EventPublish":                    true,
+	"EventUnPublish":                  true,
+	"EventCancel":                     true,
+	"EventDelete":                     true,
+	"EventGetDisplaySettings":         true,
+	"EventUpdateDisplaySettings":      true,
+	"EventGetTicketClasses":           true,
+	"EventCreateTicketClass":          true,
+	"EventGetTicketClass":             true,
+	"EventUpdateTicketClass":          true,
+	"EventDeleteTicketClass":          true,
+	"EventGetCannedQuestions":         true,
+	"EventCreateCannedQuestion":       true,
+	"EventGetQuestions":               true,
+	"EventCreateQuestion":             true,
+	"EventGetQuestion":                true,
+	"EventSeriesCreate":               true,
+	"EventSeriesGet":                  true,
+	"EventSeriesEvents":               true,
+	"EventSeriesPublish":              true,
+	"EventSeriesUnPublish":            true,
+	"EventSeriesCancel":               true,
+	"EventSeriesDelete":               true,
+	"EventSeriesCUD":                  true,
+	"OrderGet":                        true,
+	"VenueGet":                        true,
+	"VenueUpdate":                     true,
+	"VenueCreate":                     true,
+	"VenueEvents":                     true,
+	"OrganizerCreate":                 true,
+	"OrganizerGet":                    true,
+	"OrganizerUpdate":                 true,
+	"OrganizerGetEvents":              true,
+	"User":                            true,
+	"UserOrders":                      true,
+	"UserOrganizers":                  true,
+	"UserOwnedEvents":                 true,
+	"UserEvents":                      true,
+	"UserVenues":                      true,
+	"UserEventAttendees":              true,
+	"UserEventOrders":                 true,
+	"UserContactLists":                true,
+	"UserCreateContactList":           true,
+	"UserContactList":                 true,
+	"UserUpdateContactList":           true,
+	"UserDeleteContactList":           true,
+	"UserListContactContacts":         true,
+	"UserListContactAddContacts":      true,
+	"UserListContactDeleteContacts":   true,
+	"UserBookmarks":                   true,
+	"UserSaveBookmarks":               true,
+	"UserUnSaveBookmarks":             true,
+	"UserAssortments":                 true,
+	"UserSetAssortments":              true,
+	"UserTicketGroups":                true,
+	"CheckoutGetList":                 true,
+	"CheckoutMethods":                 true,
+	"CheckoutForAccount":              true,
+	"CheckoutCreate":                  true,
+	"CheckoutGet":                     true,
+	"CheckoutByEvent":                 true,
+	"CheckoutAssociate":               true,
+	"CheckoutAssociatePayoutSettings": true,
+	"DiscountsGet":                    true,
+	"DiscountCreate":                  true,
+	"DiscountUpdate":                  true,
+	"DiscountDelete":                  true,
+	"TicketGroupGet":                  true,
+	"TicketGroupDelete":               true,
+	"TicketGroupCreate":               true,
+	"TicketGroupUpdate":               true,
+	"MediaGet":                        true,
+	"MediaGetUpload":                  true,
+	"MediaCreate":                     true,
+	"Notifications":                   true,
+	"FeeRate":                         true,
+	"RefundRequest":                   true,
+	"RefundRequestUpdate":             true,
+	"RefundRequestCreate":             true,
+	"ReportSales":                     true,
+	"ReportAttendees":                 true,
+	"TrackingBeaconCreate":            true,
+	"TrackingBeaconGet":               true,
+	"TrackingBeaconUpdate":            true,
+	"TrackingBeaconDelete":            true,
+	"TrackingBeaconGetForEvent":       true,
+	"TrackingBeaconGetForUser":        true,
+	"WebhookGet":                      true,
+	"WebhookDelete":                   true,
+	"Webhooks":                        true,
+	"WebhookCreate":                   true,
+}
+
+// DecorateCategoryService wraps next with the interceptors, the first one being the outermost
+func DecorateCategoryService(next CategoryService, interceptors ...Interceptor) CategoryService {
+	return &categoryServiceDecorator{decorator{interceptors}, next}
+}
+
+type categoryServiceDecorator struct {
+	decorator
+	next CategoryService
+}
+
+func (d *categoryServiceDecorator) Categories(ctx context.Context) (*CategoriesResult, error) {
+	call := &Call{Operation: "Categories", Args: []interface{}{}, Mutating: false, newResult: func() interface{} { return new(CategoriesResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.Categories(ctx)
+	})
+	return decoratedResult[CategoriesResult](call, res, err)
+}
+
+func (d *categoryServiceDecorator) Category(ctx context.Context, id string) (*Category, error) {
+	call := &Call{Operation: "Category", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(Category) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.Category(ctx, id)
+	})
+	return decoratedResult[Category](call, res, err)
+}
+
+func (d *categoryServiceDecorator) SubCategories(ctx context.Context) (*SubCategoriesResult, error) {
+	call := &Call{Operation: "SubCategories", Args: []interface{}{}, Mutating: false, newResult: func() interface{} { return new(SubCategoriesResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.SubCategories(ctx)
+	})
+	return decoratedResult[SubCategoriesResult](call, res, err)
+}
+
+func (d *categoryServiceDecorator) SubCategory(ctx context.Context, id string) (*SubCategory, error) {
+	call := &Call{Operation: "SubCategory", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(SubCategory) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.SubCategory(ctx, id)
+	})
+	return decoratedResult[SubCategory](call, res, err)
+}
+
+// DecorateFormatService wraps next with the interceptors, the first one being the outermost
+func DecorateFormatService(next FormatService, interceptors ...Interceptor) FormatService {
+	return &formatServiceDecorator{decorator{interceptors}, next}
+}
+
+type formatServiceDecorator struct {
+	decorator
+	next FormatService
+}
+
+func (d *formatServiceDecorator) Formats(ctx context.Context) (*FormatResult, error) {
+	call := &Call{Operation: "Formats", Args: []interface{}{}, Mutating: false, newResult: func() interface{} { return new(FormatResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.Formats(ctx)
+	})
+	return decoratedResult[FormatResult](call, res, err)
+}
+
+func (d *formatServiceDecorator) Format(ctx context.Context, id string) (*Format, error) {
+	call := &Call{Operation: "Format", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(Format) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.Format(ctx, id)
+	})
+	return decoratedResult[Format](call, res, err)
+}
+
+// DecorateSystemService wraps next with the interceptors, the first one being the outermost
+func DecorateSystemService(next SystemService, interceptors ...Interceptor) SystemService {
+	return &systemServiceDecorator{decorator{interceptors}, next}
+}
+
+type systemServiceDecorator struct {
+	decorator
+	next SystemService
+}
+
+func (d *systemServiceDecorator) Timezones(ctx context.Context) (*Timezones, error) {
+	call := &Call{Operation: "Timezones", Args: []interface{}{}, Mutating: false, newResult: func() interface{} { return new(Timezones) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.Timezones(ctx)
+	})
+	return decoratedResult[Timezones](call, res, err)
+}
+
+func (d *systemServiceDecorator) Regions(ctx context.Context) (*Regions, error) {
+	call := &Call{Operation: "Regions", Args: []interface{}{}, Mutating: false, newResult: func() interface{} { return new(Regions) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.Regions(ctx)
+	})
+	return decoratedResult[Regions](call, res, err)
+}
+
+func (d *systemServiceDecorator) Countries(ctx context.Context) (*Countries, error) {
+	call := &Call{Operation: "Countries", Args: []interface{}{}, Mutating: false, newResult: func() interface{} { return new(Countries) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.Countries(ctx)
+	})
+	return decoratedResult[Countries](call, res, err)
+}
+
+// DecorateEventService wraps next with the interceptors, the first one being the outermost
+func DecorateEventService(next EventService, interceptors ...Interceptor) EventService {
+	return &eventServiceDecorator{decorator{interceptors}, next}
+}
+
+type eventServiceDecorator struct {
+	decorator
+	next EventService
+}
+
+func (d *eventServiceDecorator) EventSearch(ctx context.Context, req *EventSearchRequest, expand ...Expansion) (*EventSearchResult, error) {
+	call := &Call{Operation: "EventSearch", Args: []interface{}{req, expand}, Mutating: false, newResult: func() interface{} { return new(EventSearchResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventSearch(ctx, req, expand...)
+	})
+	return decoratedResult[EventSearchResult](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventGet(ctx context.Context, id string, expand ...Expansion) (*Event, error) {
+	call := &Call{Operation: "EventGet", Args: []interface{}{id, expand}, Mutating: false, newResult: func() interface{} { return new(Event) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventGet(ctx, id, expand...)
+	})
+	return decoratedResult[Event](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventCreate(ctx context.Context, req *EventCreateRequest) (*Event, error) {
+	call := &Call{Operation: "EventCreate", Args: []interface{}{req}, Mutating: true, newResult: func() interface{} { return new(Event) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventCreate(ctx, req)
+	})
+	return decoratedResult[Event](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventUpdate(ctx context.Context, id string, req *EventUpdateRequest) (*Event, error) {
+	call := &Call{Operation: "EventUpdate", Args: []interface{}{id, req}, Mutating: true, newResult: func() interface{} { return new(Event) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventUpdate(ctx, id, req)
+	})
+	return decoratedResult[Event](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventPublish(ctx context.Context, id string) (*PublishResult, error) {
+	call := &Call{Operation: "EventPublish", Args: []interface{}{id}, Mutating: true, newResult: func() interface{} { return new(PublishResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventPublish(ctx, id)
+	})
+	return decoratedResult[PublishResult](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventUnPublish(ctx context.Context, id string) (*UnpublishResult, error) {
+	call := &Call{Operation: "EventUnPublish", Args: []interface{}{id}, Mutating: true, newResult: func() interface{} { return new(UnpublishResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventUnPublish(ctx, id)
+	})
+	return decoratedResult[UnpublishResult](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventCancel(ctx context.Context, id string) (*CancelResult, error) {
+	call := &Call{Operation: "EventCancel", Args: []interface{}{id}, Mutating: true, newResult: func() interface{} { return new(CancelResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventCancel(ctx, id)
+	})
+	return decoratedResult[CancelResult](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventDelete(ctx context.Context, id string) (*DeleteResult, error) {
+	call := &Call{Operation: "EventDelete", Args: []interface{}{id}, Mutating: true, newResult: func() interface{} { return new(DeleteResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventDelete(ctx, id)
+	})
+	return decoratedResult[DeleteResult](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventGetDisplaySettings(ctx context.Context, id string) (*EventSettings, error) {
+	call := &Call{Operation: "EventGetDisplaySettings", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(EventSettings) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventGetDisplaySettings(ctx, id)
+	})
+	return decoratedResult[EventSettings](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventUpdateDisplaySettings(ctx context.Context, id string, settings *EventUpdateDisplaySettings) (*EventSettings, error) {
+	call := &Call{Operation: "EventUpdateDisplaySettings", Args: []interface{}{id, settings}, Mutating: true, newResult: func() interface{} { return new(EventSettings) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventUpdateDisplaySettings(ctx, id, settings)
+	})
+	return decoratedResult[EventSettings](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventGetTicketClasses(ctx context.Context, id string, class *EventGetTicketClass) (*EventGetTicketClassResult, error) {
+	call := &Call{Operation: "EventGetTicketClasses", Args: []interface{}{id, class}, Mutating: false, newResult: func() interface{} { return new(EventGetTicketClassResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventGetTicketClasses(ctx, id, class)
+	})
+	return decoratedResult[EventGetTicketClassResult](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventCreateTicketClass(ctx context.Context, id string, class *EventCreateTicketClass) (*TicketClass, error) {
+	call := &Call{Operation: "EventCreateTicketClass", Args: []interface{}{id, class}, Mutating: true, newResult: func() interface{} { return new(TicketClass) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventCreateTicketClass(ctx, id, class)
+	})
+	return decoratedResult[TicketClass](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventGetTicketClass(ctx context.Context, eventId string, ticketId string) (*TicketClass, error) {
+	call := &Call{Operation: "EventGetTicketClass", Args: []interface{}{eventId, ticketId}, Mutating: false, newResult: func() interface{} { return new(TicketClass) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventGetTicketClass(ctx, eventId, ticketId)
+	})
+	return decoratedResult[TicketClass](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventUpdateTicketClass(ctx context.Context, eventId string, ticketId string, class *EventUpdateTicketClass) (*TicketClass, error) {
+	call := &Call{Operation: "EventUpdateTicketClass", Args: []interface{}{eventId, ticketId, class}, Mutating: true, newResult: func() interface{} { return new(TicketClass) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventUpdateTicketClass(ctx, eventId, ticketId, class)
+	})
+	return decoratedResult[TicketClass](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventDeleteTicketClass(ctx context.Context, eventId string, ticketId string, class *EventDeleteTicketClass) (*DeleteResult, error) {
+	call := &Call{Operation: "EventDeleteTicketClass", Args: []interface{}{eventId, ticketId, class}, Mutating: true, newResult: func() interface{} { return new(DeleteResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventDeleteTicketClass(ctx, eventId, ticketId, class)
+	})
+	return decoratedResult[DeleteResult](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventGetCannedQuestions(ctx context.Context, id string, q *EventGetCannedQuestions) (*QuestionsResult, error) {
+	call := &Call{Operation: "EventGetCannedQuestions", Args: []interface{}{id, q}, Mutating: false, newResult: func() interface{} { return new(QuestionsResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventGetCannedQuestions(ctx, id, q)
+	})
+	return decoratedResult[QuestionsResult](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventCreateCannedQuestion(ctx context.Context, id string, q *EventCreateCannedQuestion) (*Question, error) {
+	call := &Call{Operation: "EventCreateCannedQuestion", Args: []interface{}{id, q}, Mutating: true, newResult: func() interface{} { return new(Question) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventCreateCannedQuestion(ctx, id, q)
+	})
+	return decoratedResult[Question](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventGetQuestions(ctx context.Context, id string, q *EventGetQuestions) (*QuestionsResult, error) {
+	call := &Call{Operation: "EventGetQuestions", Args: []interface{}{id, q}, Mutating: false, newResult: func() interface{} { return new(QuestionsResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventGetQuestions(ctx, id, q)
+	})
+	return decoratedResult[QuestionsResult](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventCreateQuestion(ctx context.Context, id string, q *EventCreateQuestion) (*Question, error) {
+	call := &Call{Operation: "EventCreateQuestion", Args: []interface{}{id, q}, Mutating: true, newResult: func() interface{} { return new(Question) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventCreateQuestion(ctx, id, q)
+	})
+	return decoratedResult[Question](call, res, err)
+}
+
+func (d *eventServiceDecorator) EventGetQuestion(ctx context.Context, eventId string, questionId string) (*Question, error) {
+	call := &Call{Operation: "EventGetQuestion", Args: []interface{}{eventId, questionId}, Mutating: false, newResult: func() interface{} { return new(Question) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventGetQuestion(ctx, eventId, questionId)
+	})
+	return decoratedResult[Question](call, res, err)
+}
+
+// DecorateSeriesService wraps next with the interceptors, the first one being the outermost
+func DecorateSeriesService(next SeriesService, interceptors ...Interceptor) SeriesService {
+	return &seriesServiceDecorator{decorator{interceptors}, next}
+}
+
+type seriesServiceDecorator struct {
+	decorator
+	next SeriesService
+}
+
+func (d *seriesServiceDecorator) EventSeriesCreate(ctx context.Context, req *SeriesCreateEventRequest) (*Series, error) {
+	call := &Call{Operation: "EventSeriesCreate", Args: []interface{}{req}, Mutating: true, newResult: func() interface{} { return new(Series) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventSeriesCreate(ctx, req)
+	})
+	return decoratedResult[Series](call, res, err)
+}
+
+func (d *seriesServiceDecorator) EventSeriesGet(ctx context.Context, id string) (*Series, error) {
+	call := &Call{Operation: "EventSeriesGet", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(Series) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventSeriesGet(ctx, id)
+	})
+	return decoratedResult[Series](call, res, err)
+}
+
+func (d *seriesServiceDecorator) EventSeriesEvents(ctx context.Context, id string, req *SeriesEventRequest) (*SeriesEventsResult, error) {
+	call := &Call{Operation: "EventSeriesEvents", Args: []interface{}{id, req}, Mutating: false, newResult: func() interface{} { return new(SeriesEventsResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventSeriesEvents(ctx, id, req)
+	})
+	return decoratedResult[SeriesEventsResult](call, res, err)
+}
+
+func (d *seriesServiceDecorator) EventSeriesPublish(ctx context.Context, id string) (*PublishResult, error) {
+	call := &Call{Operation: "EventSeriesPublish", Args: []interface{}{id}, Mutating: true, newResult: func() interface{} { return new(PublishResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventSeriesPublish(ctx, id)
+	})
+	return decoratedResult[PublishResult](call, res, err)
+}
+
+func (d *seriesServiceDecorator) EventSeriesUnPublish(ctx context.Context, id string) (*UnpublishResult, error) {
+	call := &Call{Operation: "EventSeriesUnPublish", Args: []interface{}{id}, Mutating: true, newResult: func() interface{} { return new(UnpublishResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventSeriesUnPublish(ctx, id)
+	})
+	return decoratedResult[UnpublishResult](call, res, err)
+}
+
+func (d *seriesServiceDecorator) EventSeriesCancel(ctx context.Context, id string) (*CancelResult, error) {
+	call := &Call{Operation: "EventSeriesCancel", Args: []interface{}{id}, Mutating: true, newResult: func() interface{} { return new(CancelResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventSeriesCancel(ctx, id)
+	})
+	return decoratedResult[CancelResult](call, res, err)
+}
+
+func (d *seriesServiceDecorator) EventSeriesDelete(ctx context.Context, id string) (*DeleteResult, error) {
+	call := &Call{Operation: "EventSeriesDelete", Args: []interface{}{id}, Mutating: true, newResult: func() interface{} { return new(DeleteResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventSeriesDelete(ctx, id)
+	})
+	return decoratedResult[DeleteResult](call, res, err)
+}
+
+func (d *seriesServiceDecorator) EventSeriesCUD(ctx context.Context, id string, req *SeriesCUREventRequest) (*SeriesEventsResult, error) {
+	call := &Call{Operation: "EventSeriesCUD", Args: []interface{}{id, req}, Mutating: true, newResult: func() interface{} { return new(SeriesEventsResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.EventSeriesCUD(ctx, id, req)
+	})
+	return decoratedResult[SeriesEventsResult](call, res, err)
+}
+
+// DecorateOrderService wraps next with the interceptors, the first one being the outermost
+func DecorateOrderService(next OrderService, interceptors ...Interceptor) OrderService {
+	return &orderServiceDecorator{decorator{interceptors}, next}
+}
+
+type orderServiceDecorator struct {
+	decorator
+	next OrderService
+}
+
+func (d *orderServiceDecorator) OrderGet(ctx context.Context, id string, expand ...Expansion) (*Order, error) {
+	call := &Call{Operation: "OrderGet", Args: []interface{}{id, expand}, Mutating: false, newResult: func() interface{} { return new(Order) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.OrderGet(ctx, id, expand...)
+	})
+	return decoratedResult[Order](call, res, err)
+}
+
+// DecorateVenueService wraps next with the interceptors, the first one being the outermost
+func DecorateVenueService(next VenueService, interceptors ...Interceptor) VenueService {
+	return &venueServiceDecorator{decorator{interceptors}, next}
+}
+
+type venueServiceDecorator struct {
+	decorator
+	next VenueService
+}
+
+func (d *venueServiceDecorator) VenueGet(ctx context.Context, id string) (*Venue, error) {
+	call := &Call{Operation: "VenueGet", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(Venue) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.VenueGet(ctx, id)
+	})
+	return decoratedResult[Venue](call, res, err)
+}
+
+func (d *venueServiceDecorator) VenueUpdate(ctx context.Context, id string, req *UpdateVenueRequest) (*Venue, error) {
+	call := &Call{Operation: "VenueUpdate", Args: []interface{}{id, req}, Mutating: true, newResult: func() interface{} { return new(Venue) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.VenueUpdate(ctx, id, req)
+	})
+	return decoratedResult[Venue](call, res, err)
+}
+
+func (d *venueServiceDecorator) VenueCreate(ctx context.Context, req *CreateVenueRequest) (*Venue, error) {
+	call := &Call{Operation: "VenueCreate", Args: []interface{}{req}, Mutating: true, newResult: func() interface{} { return new(Venue) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.VenueCreate(ctx, req)
+	})
+	return decoratedResult[Venue](call, res, err)
+}
+
+func (d *venueServiceDecorator) VenueEvents(ctx context.Context, venueId string, expand ...Expansion) (*VenueEventsResult, error) {
+	call := &Call{Operation: "VenueEvents", Args: []interface{}{venueId, expand}, Mutating: false, newResult: func() interface{} { return new(VenueEventsResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.VenueEvents(ctx, venueId, expand...)
+	})
+	return decoratedResult[VenueEventsResult](call, res, err)
+}
+
+// DecorateOrganizerService wraps next with the interceptors, the first one being the outermost
+func DecorateOrganizerService(next OrganizerService, interceptors ...Interceptor) OrganizerService {
+	return &organizerServiceDecorator{decorator{interceptors}, next}
+}
+
+type organizerServiceDecorator struct {
+	decorator
+	next OrganizerService
+}
+
+func (d *organizerServiceDecorator) OrganizerCreate(ctx context.Context, req *CreateOrganizerRequest) (*Organizer, error) {
+	call := &Call{Operation: "OrganizerCreate", Args: []interface{}{req}, Mutating: true, newResult: func() interface{} { return new(Organizer) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.OrganizerCreate(ctx, req)
+	})
+	return decoratedResult[Organizer](call, res, err)
+}
+
+func (d *organizerServiceDecorator) OrganizerGet(ctx context.Context, id string) (*Organizer, error) {
+	call := &Call{Operation: "OrganizerGet", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(Organizer) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.OrganizerGet(ctx, id)
+	})
+	return decoratedResult[Organizer](call, res, err)
+}
+
+func (d *organizerServiceDecorator) OrganizerUpdate(ctx context.Context, id string, req *UpdateOrganizerRequest) (*Organizer, error) {
+	call := &Call{Operation: "OrganizerUpdate", Args: []interface{}{id, req}, Mutating: true, newResult: func() interface{} { return new(Organizer) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.OrganizerUpdate(ctx, id, req)
+	})
+	return decoratedResult[Organizer](call, res, err)
+}
+
+func (d *organizerServiceDecorator) OrganizerGetEvents(ctx context.Context, id string, req *OrganizerEventsRequest, expand ...Expansion) (*OrganizerEventsResult, error) {
+	call := &Call{Operation: "OrganizerGetEvents", Args: []interface{}{id, req, expand}, Mutating: false, newResult: func() interface{} { return new(OrganizerEventsResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.OrganizerGetEvents(ctx, id, req, expand...)
+	})
+	return decoratedResult[OrganizerEventsResult](call, res, err)
+}
+
+// DecorateUserService wraps next with the interceptors, the first one being the outermost
+func DecorateUserService(next UserService, interceptors ...Interceptor) UserService {
+	return &userServiceDecorator{decorator{interceptors}, next}
+}
+
+type userServiceDecorator struct {
+	decorator
+	next UserService
+}
+
+func (d *userServiceDecorator) User(ctx context.Context, id string) (*User, error) {
+	call := &Call{Operation: "User", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(User) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.User(ctx, id)
+	})
+	return decoratedResult[User](call, res, err)
+}
+
+func (d *userServiceDecorator) UserOrders(ctx context.Context, id string, req *UserEventOrders, expand ...Expansion) (*UserOrdersResult, error) {
+	call := &Call{Operation: "UserOrders", Args: []interface{}{id, req, expand}, Mutating: false, newResult: func() interface{} { return new(UserOrdersResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserOrders(ctx, id, req, expand...)
+	})
+	return decoratedResult[UserOrdersResult](call, res, err)
+}
+
+func (d *userServiceDecorator) UserOrganizers(ctx context.Context, id string, req *UserOrganizerRequest) (*UserOrganizerResponse, error) {
+	call := &Call{Operation: "UserOrganizers", Args: []interface{}{id, req}, Mutating: false, newResult: func() interface{} { return new(UserOrganizerResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserOrganizers(ctx, id, req)
+	})
+	return decoratedResult[UserOrganizerResponse](call, res, err)
+}
+
+func (d *userServiceDecorator) UserOwnedEvents(ctx context.Context, id string, req *UserOwnedEventsRequest, expand ...Expansion) (*UserOwnedEventResponse, error) {
+	call := &Call{Operation: "UserOwnedEvents", Args: []interface{}{id, req, expand}, Mutating: false, newResult: func() interface{} { return new(UserOwnedEventResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserOwnedEvents(ctx, id, req, expand...)
+	})
+	return decoratedResult[UserOwnedEventResponse](call, res, err)
+}
+
+func (d *userServiceDecorator) UserEvents(ctx context.Context, id string, req UserEventsRequest) (*UserEventsResponse, error) {
+	call := &Call{Operation: "UserEvents", Args: []interface{}{id, req}, Mutating: false, newResult: func() interface{} { return new(UserEventsResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserEvents(ctx, id, req)
+	})
+	return decoratedResult[UserEventsResponse](call, res, err)
+}
+
+func (d *userServiceDecorator) UserVenues(ctx context.Context, id string) (*UserVenuesResponse, error) {
+	call := &Call{Operation: "UserVenues", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(UserVenuesResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserVenues(ctx, id)
+	})
+	return decoratedResult[UserVenuesResponse](call, res, err)
+}
+
+func (d *userServiceDecorator) UserEventAttendees(ctx context.Context, id string, request *UserEventAttendeesRequest, expand ...Expansion) (*UserEventAttendeesResponse, error) {
+	call := &Call{Operation: "UserEventAttendees", Args: []interface{}{id, request, expand}, Mutating: false, newResult: func() interface{} { return new(UserEventAttendeesResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserEventAttendees(ctx, id, request, expand...)
+	})
+	return decoratedResult[UserEventAttendeesResponse](call, res, err)
+}
+
+func (d *userServiceDecorator) UserEventOrders(ctx context.Context, id string, request *UserEventOrdersRequest, expand ...Expansion) (*UserEventOrdersResponse, error) {
+	call := &Call{Operation: "UserEventOrders", Args: []interface{}{id, request, expand}, Mutating: false, newResult: func() interface{} { return new(UserEventOrdersResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserEventOrders(ctx, id, request, expand...)
+	})
+	return decoratedResult[UserEventOrdersResponse](call, res, err)
+}
+
+func (d *userServiceDecorator) UserContactLists(ctx context.Context, id string) (*UserContactListsResponse, error) {
+	call := &Call{Operation: "UserContactLists", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(UserContactListsResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserContactLists(ctx, id)
+	})
+	return decoratedResult[UserContactListsResponse](call, res, err)
+}
+
+func (d *userServiceDecorator) UserCreateContactList(ctx context.Context, id string, request *UserCreateContactListsRequest) (*UserContactListsResponse, error) {
+	call := &Call{Operation: "UserCreateContactList", Args: []interface{}{id, request}, Mutating: true, newResult: func() interface{} { return new(UserContactListsResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserCreateContactList(ctx, id, request)
+	})
+	return decoratedResult[UserContactListsResponse](call, res, err)
+}
+
+func (d *userServiceDecorator) UserContactList(ctx context.Context, id string, contactListID string, request *UserCreateContactListsRequest) (*UserContactListsResponse, error) {
+	call := &Call{Operation: "UserContactList", Args: []interface{}{id, contactListID, request}, Mutating: false, newResult: func() interface{} { return new(UserContactListsResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserContactList(ctx, id, contactListID, request)
+	})
+	return decoratedResult[UserContactListsResponse](call, res, err)
+}
+
+func (d *userServiceDecorator) UserUpdateContactList(ctx context.Context, id string, contactListID string, request *UserUpdateContactListRequest) (*UserContactListsResponse, error) {
+	call := &Call{Operation: "UserUpdateContactList", Args: []interface{}{id, contactListID, request}, Mutating: true, newResult: func() interface{} { return new(UserContactListsResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserUpdateContactList(ctx, id, contactListID, request)
+	})
+	return decoratedResult[UserContactListsResponse](call, res, err)
+}
+
+func (d *userServiceDecorator) UserDeleteContactList(ctx context.Context, id string, contactListID string) (*DeleteResult, error) {
+	call := &Call{Operation: "UserDeleteContactList", Args: []interface{}{id, contactListID}, Mutating: true, newResult: func() interface{} { return new(DeleteResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserDeleteContactList(ctx, id, contactListID)
+	})
+	return decoratedResult[DeleteResult](call, res, err)
+}
+
+func (d *userServiceDecorator) UserListContactContacts(ctx context.Context, id string, contactListID string) (*UserContactListContacts, error) {
+	call := &Call{Operation: "UserListContactContacts", Args: []interface{}{id, contactListID}, Mutating: false, newResult: func() interface{} { return new(UserContactListContacts) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserListContactContacts(ctx, id, contactListID)
+	})
+	return decoratedResult[UserContactListContacts](call, res, err)
+}
+
+func (d *userServiceDecorator) UserListContactAddContacts(ctx context.Context, id string, contactListID string, req *UserAddContactListContactRequest) (*UserContactListContacts, error) {
+	call := &Call{Operation: "UserListContactAddContacts", Args: []interface{}{id, contactListID, req}, Mutating: true, newResult: func() interface{} { return new(UserContactListContacts) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserListContactAddContacts(ctx, id, contactListID, req)
+	})
+	return decoratedResult[UserContactListContacts](call, res, err)
+}
+
+func (d *userServiceDecorator) UserListContactDeleteContacts(ctx context.Context, id string, contactListID string) (*DeleteResult, error) {
+	call := &Call{Operation: "UserListContactDeleteContacts", Args: []interface{}{id, contactListID}, Mutating: true, newResult: func() interface{} { return new(DeleteResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserListContactDeleteContacts(ctx, id, contactListID)
+	})
+	return decoratedResult[DeleteResult](call, res, err)
+}
+
+func (d *userServiceDecorator) UserBookmarks(ctx context.Context, id string, req *UserBookmarksRequest, expand ...Expansion) (*UserBookmarksResponse, error) {
+	call := &Call{Operation: "UserBookmarks", Args: []interface{}{id, req, expand}, Mutating: false, newResult: func() interface{} { return new(UserBookmarksResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserBookmarks(ctx, id, req, expand...)
+	})
+	return decoratedResult[UserBookmarksResponse](call, res, err)
+}
+
+func (d *userServiceDecorator) UserSaveBookmarks(ctx context.Context, id string, req *UserSaveBookmarkRequest) (*CreateResult, error) {
+	call := &Call{Operation: "UserSaveBookmarks", Args: []interface{}{id, req}, Mutating: true, newResult: func() interface{} { return new(CreateResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserSaveBookmarks(ctx, id, req)
+	})
+	return decoratedResult[CreateResult](call, res, err)
+}
+
+func (d *userServiceDecorator) UserUnSaveBookmarks(ctx context.Context, id string, req *UserUnSaveBookmarkRequest) (*DeleteResult, error) {
+	call := &Call{Operation: "UserUnSaveBookmarks", Args: []interface{}{id, req}, Mutating: true, newResult: func() interface{} { return new(DeleteResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserUnSaveBookmarks(ctx, id, req)
+	})
+	return decoratedResult[DeleteResult](call, res, err)
+}
+
+func (d *userServiceDecorator) UserAssortments(ctx context.Context, id string) (*Assortment, error) {
+	call := &Call{Operation: "UserAssortments", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(Assortment) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserAssortments(ctx, id)
+	})
+	return decoratedResult[Assortment](call, res, err)
+}
+
+func (d *userServiceDecorator) UserSetAssortments(ctx context.Context, id string, req *UserSetAssortmentRequest) (*Assortment, error) {
+	call := &Call{Operation: "UserSetAssortments", Args: []interface{}{id, req}, Mutating: true, newResult: func() interface{} { return new(Assortment) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserSetAssortments(ctx, id, req)
+	})
+	return decoratedResult[Assortment](call, res, err)
+}
+
+func (d *userServiceDecorator) UserTicketGroups(ctx context.Context, id string, req *UserTicketGroupsRequest) (*UserTicketGroupResponse, error) {
+	call := &Call{Operation: "UserTicketGroups", Args: []interface{}{id, req}, Mutating: false, newResult: func() interface{} { return new(UserTicketGroupResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.UserTicketGroups(ctx, id, req)
+	})
+	return decoratedResult[UserTicketGroupResponse](call, res, err)
+}
+
+// DecorateCheckoutService wraps next with the interceptors, the first one being the outermost
+func DecorateCheckoutService(next CheckoutService, interceptors ...Interceptor) CheckoutService {
+	return &checkoutServiceDecorator{decorator{interceptors}, next}
+}
+
+type checkoutServiceDecorator struct {
+	decorator
+	next CheckoutService
+}
+
+func (d *checkoutServiceDecorator) CheckoutGetList(ctx context.Context) (*Checkout, error) {
+	call := &Call{Operation: "CheckoutGetList", Args: []interface{}{}, Mutating: false, newResult: func() interface{} { return new(Checkout) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.CheckoutGetList(ctx)
+	})
+	return decoratedResult[Checkout](call, res, err)
+}
+
+func (d *checkoutServiceDecorator) CheckoutMethods(ctx context.Context, req CheckoutMethodsRequest) (*CheckoutMethodsResponse, error) {
+	call := &Call{Operation: "CheckoutMethods", Args: []interface{}{req}, Mutating: false, newResult: func() interface{} { return new(CheckoutMethodsResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.CheckoutMethods(ctx, req)
+	})
+	return decoratedResult[CheckoutMethodsResponse](call, res, err)
+}
+
+func (d *checkoutServiceDecorator) CheckoutForAccount(ctx context.Context, req *CheckoutForAccountRequest) (*CheckoutSettingsForAccount, error) {
+	call := &Call{Operation: "CheckoutForAccount", Args: []interface{}{req}, Mutating: false, newResult: func() interface{} { return new(CheckoutSettingsForAccount) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.CheckoutForAccount(ctx, req)
+	})
+	return decoratedResult[CheckoutSettingsForAccount](call, res, err)
+}
+
+func (d *checkoutServiceDecorator) CheckoutCreate(ctx context.Context, req *CheckoutCreateRequest) (*Checkout, error) {
+	call := &Call{Operation: "CheckoutCreate", Args: []interface{}{req}, Mutating: true, newResult: func() interface{} { return new(Checkout) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.CheckoutCreate(ctx, req)
+	})
+	return decoratedResult[Checkout](call, res, err)
+}
+
+func (d *checkoutServiceDecorator) CheckoutGet(ctx context.Context, id string) (*Checkout, error) {
+	call := &Call{Operation: "CheckoutGet", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(Checkout) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.CheckoutGet(ctx, id)
+	})
+	return decoratedResult[Checkout](call, res, err)
+}
+
+func (d *checkoutServiceDecorator) CheckoutByEvent(ctx context.Context, eventId string) (*EventCheckoutSettings, error) {
+	call := &Call{Operation: "CheckoutByEvent", Args: []interface{}{eventId}, Mutating: false, newResult: func() interface{} { return new(EventCheckoutSettings) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.CheckoutByEvent(ctx, eventId)
+	})
+	return decoratedResult[EventCheckoutSettings](call, res, err)
+}
+
+func (d *checkoutServiceDecorator) CheckoutAssociate(ctx context.Context, eventID string, req *CheckoutAssociateToEventRequest) (*EventCheckoutSettings, error) {
+	call := &Call{Operation: "CheckoutAssociate", Args: []interface{}{eventID, req}, Mutating: true, newResult: func() interface{} { return new(EventCheckoutSettings) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.CheckoutAssociate(ctx, eventID, req)
+	})
+	return decoratedResult[EventCheckoutSettings](call, res, err)
+}
+
+func (d *checkoutServiceDecorator) CheckoutAssociatePayoutSettings(ctx context.Context, eventID string, req *CheckoutAssociatePayoutToEvent) (*PayoutSettings, error) {
+	call := &Call{Operation: "CheckoutAssociatePayoutSettings", Args: []interface{}{eventID, req}, Mutating: true, newResult: func() interface{} { return new(PayoutSettings) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.CheckoutAssociatePayoutSettings(ctx, eventID, req)
+	})
+	return decoratedResult[PayoutSettings](call, res, err)
+}
+
+// DecorateDiscountService wraps next with the interceptors, the first one being the outermost
+func DecorateDiscountService(next DiscountService, interceptors ...Interceptor) DiscountService {
+	return &discountServiceDecorator{decorator{interceptors}, next}
+}
+
+type discountServiceDecorator struct {
+	decorator
+	next DiscountService
+}
+
+func (d *discountServiceDecorator) DiscountsGet(ctx context.Context, id string) (*CrossEventDiscount, error) {
+	call := &Call{Operation: "DiscountsGet", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(CrossEventDiscount) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.DiscountsGet(ctx, id)
+	})
+	return decoratedResult[CrossEventDiscount](call, res, err)
+}
+
+func (d *discountServiceDecorator) DiscountCreate(ctx context.Context, req *DiscountCreateRequest) (*CrossEventDiscount, error) {
+	call := &Call{Operation: "DiscountCreate", Args: []interface{}{req}, Mutating: true, newResult: func() interface{} { return new(CrossEventDiscount) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.DiscountCreate(ctx, req)
+	})
+	return decoratedResult[CrossEventDiscount](call, res, err)
+}
+
+func (d *discountServiceDecorator) DiscountUpdate(ctx context.Context, id string, req *DiscountUpdateRequest) (*CrossEventDiscount, error) {
+	call := &Call{Operation: "DiscountUpdate", Args: []interface{}{id, req}, Mutating: true, newResult: func() interface{} { return new(CrossEventDiscount) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.DiscountUpdate(ctx, id, req)
+	})
+	return decoratedResult[CrossEventDiscount](call, res, err)
+}
+
+func (d *discountServiceDecorator) DiscountDelete(ctx context.Context, id string) (*DeleteResult, error) {
+	call := &Call{Operation: "DiscountDelete", Args: []interface{}{id}, Mutating: true, newResult: func() interface{} { return new(DeleteResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.DiscountDelete(ctx, id)
+	})
+	return decoratedResult[DeleteResult](call, res, err)
+}
+
+// DecorateTicketGroupService wraps next with the interceptors, the first one being the outermost
+func DecorateTicketGroupService(next TicketGroupService, interceptors ...Interceptor) TicketGroupService {
+	return &ticketGroupServiceDecorator{decorator{interceptors}, next}
+}
+
+type ticketGroupServiceDecorator struct {
+	decorator
+	next TicketGroupService
+}
+
+func (d *ticketGroupServiceDecorator) TicketGroupGet(ctx context.Context, id string) (*TicketGroup, error) {
+	call := &Call{Operation: "TicketGroupGet", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(TicketGroup) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.TicketGroupGet(ctx, id)
+	})
+	return decoratedResult[TicketGroup](call, res, err)
+}
+
+func (d *ticketGroupServiceDecorator) TicketGroupDelete(ctx context.Context, id string) (*DeleteResult, error) {
+	call := &Call{Operation: "TicketGroupDelete", Args: []interface{}{id}, Mutating: true, newResult: func() interface{} { return new(DeleteResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.TicketGroupDelete(ctx, id)
+	})
+	return decoratedResult[DeleteResult](call, res, err)
+}
+
+func (d *ticketGroupServiceDecorator) TicketGroupCreate(ctx context.Context, id string, req *CreateTicketGroupRequest) (*TicketGroup, error) {
+	call := &Call{Operation: "TicketGroupCreate", Args: []interface{}{id, req}, Mutating: true, newResult: func() interface{} { return new(TicketGroup) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.TicketGroupCreate(ctx, id, req)
+	})
+	return decoratedResult[TicketGroup](call, res, err)
+}
+
+func (d *ticketGroupServiceDecorator) TicketGroupUpdate(ctx context.Context, id string, req *UpdateTicketGroupRequest) (*TicketGroup, error) {
+	call := &Call{Operation: "TicketGroupUpdate", Args: []interface{}{id, req}, Mutating: true, newResult: func() interface{} { return new(TicketGroup) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.TicketGroupUpdate(ctx, id, req)
+	})
+	return decoratedResult[TicketGroup](call, res, err)
+}
+
+// DecorateMediaService wraps next with the interceptors, the first one being the outermost
+func DecorateMediaService(next MediaService, interceptors ...Interceptor) MediaService {
+	return &mediaServiceDecorator{decorator{interceptors}, next}
+}
+
+type mediaServiceDecorator struct {
+	decorator
+	next MediaService
+}
+
+func (d *mediaServiceDecorator) MediaGet(ctx context.Context, req *MediaGetUpload) (*Media, error) {
+	call := &Call{Operation: "MediaGet", Args: []interface{}{req}, Mutating: false, newResult: func() interface{} { return new(Media) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.MediaGet(ctx, req)
+	})
+	return decoratedResult[Media](call, res, err)
+}
+
+func (d *mediaServiceDecorator) MediaGetUpload(ctx context.Context, id string) (*Image, error) {
+	call := &Call{Operation: "MediaGetUpload", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(Image) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.MediaGetUpload(ctx, id)
+	})
+	return decoratedResult[Image](call, res, err)
+}
+
+func (d *mediaServiceDecorator) MediaCreate(ctx context.Context, req *MediaCreateUpload) (*Image, error) {
+	call := &Call{Operation: "MediaCreate", Args: []interface{}{req}, Mutating: false, newResult: func() interface{} { return new(Image) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.MediaCreate(ctx, req)
+	})
+	return decoratedResult[Image](call, res, err)
+}
+
+// DecorateNotificationService wraps next with the interceptors, the first one being the outermost
+func DecorateNotificationService(next NotificationService, interceptors ...Interceptor) NotificationService {
+	return &notificationServiceDecorator{decorator{interceptors}, next}
+}
+
+type notificationServiceDecorator struct {
+	decorator
+	next NotificationService
+}
+
+func (d *notificationServiceDecorator) Notifications(ctx context.Context) (*NotificationsResult, error) {
+	call := &Call{Operation: "Notifications", Args: []interface{}{}, Mutating: false, newResult: func() interface{} { return new(NotificationsResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.Notifications(ctx)
+	})
+	return decoratedResult[NotificationsResult](call, res, err)
+}
+
+// DecoratePricingService wraps next with the interceptors, the first one being the outermost
+func DecoratePricingService(next PricingService, interceptors ...Interceptor) PricingService {
+	return &pricingServiceDecorator{decorator{interceptors}, next}
+}
+
+type pricingServiceDecorator struct {
+	decorator
+	next PricingService
+}
+
+func (d *pricingServiceDecorator) FeeRate(ctx context.Context, req *FeeRequest) (*FeeResponse, error) {
+	call := &Call{Operation: "FeeRate", Args: []interface{}{req}, Mutating: false, newResult: func() interface{} { return new(FeeResponse) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.FeeRate(ctx, req)
+	})
+	return decoratedResult[FeeResponse](call, res, err)
+}
+
+// DecorateRefundRequestService wraps next with the interceptors, the first one being the outermost
+func DecorateRefundRequestService(next RefundRequestService, interceptors ...Interceptor) RefundRequestService {
+	return &refundRequestServiceDecorator{decorator{interceptors}, next}
+}
+
+type refundRequestServiceDecorator struct {
+	decorator
+	next RefundRequestService
+}
+
+func (d *refundRequestServiceDecorator) RefundRequest(ctx context.Context, id string) (*RefundRequest, error) {
+	call := &Call{Operation: "RefundRequest", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(RefundRequest) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.RefundRequest(ctx, id)
+	})
+	return decoratedResult[RefundRequest](call, res, err)
+}
+
+func (d *refundRequestServiceDecorator) RefundRequestUpdate(ctx context.Context, id string, req *UpdateOrganizerRequest) (*RefundRequest, error) {
+	call := &Call{Operation: "RefundRequestUpdate", Args: []interface{}{id, req}, Mutating: true, newResult: func() interface{} { return new(RefundRequest) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.RefundRequestUpdate(ctx, id, req)
+	})
+	return decoratedResult[RefundRequest](call, res, err)
+}
+
+func (d *refundRequestServiceDecorator) RefundRequestCreate(ctx context.Context, req *CreateRefundRequest) (*RefundRequest, error) {
+	call := &Call{Operation: "RefundRequestCreate", Args: []interface{}{req}, Mutating: true, newResult: func() interface{} { return new(RefundRequest) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.RefundRequestCreate(ctx, req)
+	})
+	return decoratedResult[RefundRequest](call, res, err)
+}
+
+// DecorateReportService wraps next with the interceptors, the first one being the outermost
+func DecorateReportService(next ReportService, interceptors ...Interceptor) ReportService {
+	return &reportServiceDecorator{decorator{interceptors}, next}
+}
+
+type reportServiceDecorator struct {
+	decorator
+	next ReportService
+}
+
+func (d *reportServiceDecorator) ReportSales(ctx context.Context, req *ReportRequest) (*Report, error) {
+	call := &Call{Operation: "ReportSales", Args: []interface{}{req}, Mutating: false, newResult: func() interface{} { return new(Report) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.ReportSales(ctx, req)
+	})
+	return decoratedResult[Report](call, res, err)
+}
+
+func (d *reportServiceDecorator) ReportAttendees(ctx context.Context, req *ReportAttendees) (*Report, error) {
+	call := &Call{Operation: "ReportAttendees", Args: []interface{}{req}, Mutating: false, newResult: func() interface{} { return new(Report) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.ReportAttendees(ctx, req)
+	})
+	return decoratedResult[Report](call, res, err)
+}
+
+// DecorateTrackingBeaconService wraps next with the interceptors, the first one being the outermost
+func DecorateTrackingBeaconService(next TrackingBeaconService, interceptors ...Interceptor) TrackingBeaconService {
+	return &trackingBeaconServiceDecorator{decorator{interceptors}, next}
+}
+
+type trackingBeaconServiceDecorator struct {
+	decorator
+	next TrackingBeaconService
+}
+
+func (d *trackingBeaconServiceDecorator) TrackingBeaconCreate(ctx context.Context, req *CreateTrackingBeaconRequest) (*TrackingBeacon, error) {
+	call := &Call{Operation: "TrackingBeaconCreate", Args: []interface{}{req}, Mutating: true, newResult: func() interface{} { return new(TrackingBeacon) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.TrackingBeaconCreate(ctx, req)
+	})
+	return decoratedResult[TrackingBeacon](call, res, err)
+}
+
+func (d *trackingBeaconServiceDecorator) TrackingBeaconGet(ctx context.Context, id string, req *GetTrackingBeaconRequest) (*TrackingBeacon, error) {
+	call := &Call{Operation: "TrackingBeaconGet", Args: []interface{}{id, req}, Mutating: false, newResult: func() interface{} { return new(TrackingBeacon) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.TrackingBeaconGet(ctx, id, req)
+	})
+	return decoratedResult[TrackingBeacon](call, res, err)
+}
+
+func (d *trackingBeaconServiceDecorator) TrackingBeaconUpdate(ctx context.Context, id string, req *UpdateTrackingBeaconRequest) (*TrackingBeacon, error) {
+	call := &Call{Operation: "TrackingBeaconUpdate", Args: []interface{}{id, req}, Mutating: true, newResult: func() interface{} { return new(TrackingBeacon) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.TrackingBeaconUpdate(ctx, id, req)
+	})
+	return decoratedResult[TrackingBeacon](call, res, err)
+}
+
+func (d *trackingBeaconServiceDecorator) TrackingBeaconDelete(ctx context.Context, id string) (*TrackingBeacon, error) {
+	call := &Call{Operation: "TrackingBeaconDelete", Args: []interface{}{id}, Mutating: true, newResult: func() interface{} { return new(TrackingBeacon) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.TrackingBeaconDelete(ctx, id)
+	})
+	return decoratedResult[TrackingBeacon](call, res, err)
+}
+
+func (d *trackingBeaconServiceDecorator) TrackingBeaconGetForEvent(ctx context.Context, eventId string, req *GetTrackingBeaconForEventRequest) (*TrackingBeacon, error) {
+	call := &Call{Operation: "TrackingBeaconGetForEvent", Args: []interface{}{eventId, req}, Mutating: false, newResult: func() interface{} { return new(TrackingBeacon) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.TrackingBeaconGetForEvent(ctx, eventId, req)
+	})
+	return decoratedResult[TrackingBeacon](call, res, err)
+}
+
+func (d *trackingBeaconServiceDecorator) TrackingBeaconGetForUser(ctx context.Context, userId string, req *GetTrackingBeaconForUserRequest) (*TrackingBeacon, error) {
+	call := &Call{Operation: "TrackingBeaconGetForUser", Args: []interface{}{userId, req}, Mutating: false, newResult: func() interface{} { return new(TrackingBeacon) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.TrackingBeaconGetForUser(ctx, userId, req)
+	})
+	return decoratedResult[TrackingBeacon](call, res, err)
+}
+
+// DecorateWebhookService wraps next with the interceptors, the first one being the outermost
+func DecorateWebhookService(next WebhookService, interceptors ...Interceptor) WebhookService {
+	return &webhookServiceDecorator{decorator{interceptors}, next}
+}
+
+type webhookServiceDecorator struct {
+	decorator
+	next WebhookService
+}
+
+func (d *webhookServiceDecorator) WebhookGet(ctx context.Context, id string) (*Webhook, error) {
+	call := &Call{Operation: "WebhookGet", Args: []interface{}{id}, Mutating: false, newResult: func() interface{} { return new(Webhook) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.WebhookGet(ctx, id)
+	})
+	return decoratedResult[Webhook](call, res, err)
+}
+
+func (d *webhookServiceDecorator) WebhookDelete(ctx context.Context, id string) (*Webhook, error) {
+	call := &Call{Operation: "WebhookDelete", Args: []interface{}{id}, Mutating: true, newResult: func() interface{} { return new(Webhook) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.WebhookDelete(ctx, id)
+	})
+	return decoratedResult[Webhook](call, res, err)
+}
+
+func (d *webhookServiceDecorator) Webhooks(ctx context.Context, req *WebhooksRequest) (*WebhooksResult, error) {
+	call := &Call{Operation: "Webhooks", Args: []interface{}{req}, Mutating: false, newResult: func() interface{} { return new(WebhooksResult) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.Webhooks(ctx, req)
+	})
+	return decoratedResult[WebhooksResult](call, res, err)
+}
+
+func (d *webhookServiceDecorator) WebhookCreate(ctx context.Context, req *CreateWebhookRequest) (*Webhook, error) {
+	call := &Call{Operation: "WebhookCreate", Args: []interface{}{req}, Mutating: true, newResult: func() interface{} { return new(Webhook) }}
+	res, err := d.intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return d.next.WebhookCreate(ctx, req)
+	})
+	return decoratedResult[Webhook](call, res, err)
+}
@@ -0,0 +1,110 @@
+package eventbrite
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestOperations(t *testing.T) {
+	services := reflect.TypeOf((*Services)(nil)).Elem()
+	if len(operations) != services.NumMethod() {
+		t.Errorf("got %d operations, want the %d methods of Services", len(operations), services.NumMethod())
+	}
+	for i := 0; i < services.NumMethod(); i++ {
+		if name := services.Method(i).Name; !operations[name] {
+			t.Errorf("operation %s missing", name)
+		}
+	}
+}
+
+// TestMutatingOperations checks mutatingOperations against the requests the client sends: an
+// operation is mutating if and only if a read-only client denies it
+func TestMutatingOperations(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}, WithReadOnly(), WithRetryPolicy(RetryPolicy{}))
+	client := reflect.ValueOf(c)
+
+	for name := range operations {
+		t.Run(name, func(t *testing.T) {
+			m := client.MethodByName(name)
+			in := []reflect.Value{reflect.ValueOf(context.Background())}
+			n := m.Type().NumIn()
+			if m.Type().IsVariadic() {
+				n--
+			}
+			for i := 1; i < n; i++ {
+				in = append(in, reflect.Zero(m.Type().In(i)))
+			}
+			err, _ := m.Call(in)[1].Interface().(error)
+
+			if denied := errors.Is(err, ErrOperationNotPermitted); denied != mutatingOperations[name] {
+				t.Errorf("denied by a read-only client: %t, want %t (error %v)", denied, mutatingOperations[name], err)
+			}
+		})
+	}
+}
+
+// TestOperationNames checks that callerOperation resolves every operation of Services, and every
+// iterator of Client, to its name, as the policy of the client denies the unnamed ones
+func TestOperationNames(t *testing.T) {
+	names := make([]string, 0, len(operations))
+	for name := range operations {
+		names = append(names, name)
+	}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}, WithDeniedOperations(names...), WithRetryPolicy(RetryPolicy{}))
+	client := reflect.ValueOf(c)
+
+	// call returns the error of the method called with zero arguments
+	call := func(m reflect.Value) error {
+		in := []reflect.Value{reflect.ValueOf(context.Background())}
+		n := m.Type().NumIn()
+		if m.Type().IsVariadic() {
+			n--
+		}
+		for i := 1; i < n; i++ {
+			in = append(in, reflect.Zero(m.Type().In(i)))
+		}
+		out := m.Call(in)
+		if strings.HasSuffix(m.Type().Out(0).String(), "]") && m.Type().NumOut() == 1 {
+			// an iterator, fetching its first page
+			out = out[0].MethodByName("All").Call([]reflect.Value{reflect.ValueOf(0)})
+		}
+		err, _ := out[len(out)-1].Interface().(error)
+		return err
+	}
+
+	for i := 0; i < client.NumMethod(); i++ {
+		name := client.Type().Method(i).Name
+		operation := strings.TrimSuffix(name, "Iterator")
+		if !operations[operation] {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			var denied OperationNotPermittedError
+			if err := call(client.Method(i)); !errors.As(err, &denied) || denied.Operation != operation {
+				t.Errorf("got error %v, want %s denied", err, operation)
+			}
+		})
+	}
+}
+
+func TestPolicyDeniesUnnamedOperations(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}, WithDeniedOperations("EventDelete"))
+
+	// a request made outside of the methods of Client has no operation name
+	err := c.getJSON(context.Background(), "/events/7", nil, &Event{})
+	var denied OperationNotPermittedError
+	if !errors.As(err, &denied) || denied.Operation != "" || denied.Reason != "unknown operation" {
+		t.Errorf("got error %v, want the unnamed operation denied", err)
+	}
+}
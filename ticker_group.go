@@ -6,6 +6,8 @@ import "golang.org/x/net/context"
 //
 // https://www.eventbrite.com/developer/v3/response_formats/ticket_group/#ebapi-std:format-ticket_group
 type TicketGroup struct {
+	RawFields
+
 	ID string `json:"id"`
 	// Name of the ticket group. If it is greater than 20 characters will be truncated automatically
 	Name string `json:"name"`
@@ -27,11 +29,11 @@ type CreateTicketGroupRequest struct {
 	// Name of ticket group
 	Name string `json:"ticket_group.name" validate:"required"`
 	// The status of ticket group. Valid choices are: live, deleted, or archived
-	Status string `json:"ticket_group.status"`
+	Status string `json:"ticket_group.status,omitempty"`
 	// (‘IDs of tickets by event id for this ticket group. In the format “{“event_id”: [“ticket_class_id”, “ticket_class_id”]}”.’,)
 	//
 	// https://www.eventbrite.com/developer/v3/response_formats/basic/#ebapi-dictionary
-	Ids map[string]interface{} `json:"ticket_group.event_ticket_ids"`
+	Ids map[string]interface{} `json:"ticket_group.event_ticket_ids,omitempty"`
 }
 
 // UpdateTicketGroupRequest is the request structure to update ticket group
@@ -39,13 +41,13 @@ type CreateTicketGroupRequest struct {
 // https://www.eventbrite.com/developer/v3/endpoints/ticket_groups/#ebapi-id5
 type UpdateTicketGroupRequest struct {
 	// Name of ticket group
-	Name string `json:"ticket_group.name"`
+	Name string `json:"ticket_group.name,omitempty"`
 	// The status of ticket group. Valid choices are: live, deleted, or archived
-	Status string `json:"ticket_group.status"`
+	Status string `json:"ticket_group.status,omitempty"`
 	// (‘IDs of tickets by event id for this ticket group. In the format “{“event_id”: [“ticket_class_id”, “ticket_class_id”]}”.’,)
 	//
 	// https://www.eventbrite.com/developer/v3/response_formats/basic/#ebapi-dictionary
-	Ids map[string]interface{} `json:"ticket_group.event_ticket_ids"`
+	Ids map[string]interface{} `json:"ticket_group.event_ticket_ids,omitempty"`
 }
 
 // TicketGroupGet returns the ticket_group with the specified :ticket_group_id
@@ -60,9 +62,9 @@ func (c *Client) TicketGroupGet(ctx context.Context, id string) (*TicketGroup, e
 // TicketGroupGet deletes the ticket_group with the specified :ticket_group_id. The status of the ticket group is changed to deleted.
 //
 // https://www.eventbrite.com/developer/v3/endpoints/ticket_groups/#ebapi-delete-ticket-groups-ticket-group-id
-func (c *Client) TicketGroupDelete(ctx context.Context, id string) (interface{}, error) {
-	var res interface{}
-	return res, c.deleteJSON(ctx, "/ticket_groups/"+id, &res)
+func (c *Client) TicketGroupDelete(ctx context.Context, id string) (*DeleteResult, error) {
+	res := new(DeleteResult)
+	return res, c.deleteJSON(ctx, "/ticket_groups/"+id, res)
 }
 
 // TicketGroupGet creates a ticket group and returns the created ticket_group. Only up to 200 live ticket groups may be created;
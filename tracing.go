@@ -0,0 +1,79 @@
+package eventbrite
+
+import (
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+)
+
+// tracerName is the instrumentation name of the spans of the client
+const tracerName = "github.com/apzuk/go-eventbrite"
+
+// WithTracerProvider configures the client to create its spans with tp. Every client method
+// call gets a span, a child of the span of the context passed in, with the time spent waiting
+// for the rate limiter recorded as a separate child span. Default is the global provider of otel.
+func WithTracerProvider(tp trace.TracerProvider) ClientOption {
+	return func(c *Client) error {
+		c.tracer = tp.Tracer(tracerName)
+		return nil
+	}
+}
+
+// pathIDAttributes names the attribute holding the ID following a collection in request paths
+var pathIDAttributes = map[string]string{
+	"events":     "eventbrite.event_id",
+	"orders":     "eventbrite.order_id",
+	"attendees":  "eventbrite.attendee_id",
+	"users":      "eventbrite.user_id",
+	"organizers": "eventbrite.organizer_id",
+	"venues":     "eventbrite.venue_id",
+	"webhooks":   "eventbrite.webhook_id",
+}
+
+// startSpan starts the span of a client method call. The span is ended by the caller.
+func (c *Client) startSpan(ctx context.Context, method, path string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("eventbrite.operation", OperationName(ctx)),
+		attribute.String("http.request.method", method),
+		attribute.String("url.path", path),
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if key, ok := pathIDAttributes[segments[i]]; ok && segments[i+1] != "" && segments[i+1] != "search" {
+			attrs = append(attrs, attribute.String(key, segments[i+1]))
+		}
+	}
+	if p, ok := ctx.Value(pageKey{}).(pageParams); ok && p.page > 0 {
+		attrs = append(attrs, attribute.Int("eventbrite.page", p.page))
+	}
+
+	return c.tracer.Start(ctx, "eventbrite."+OperationName(ctx),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// traceAttempt records the outcome of an attempt on the span of the call. The attributes of
+// the last attempt are the ones kept.
+func traceAttempt(ctx context.Context, attempt int, resp *http.Response) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.Int("eventbrite.attempt", attempt),
+		attribute.Int("http.request.resend_count", attempt-1),
+	)
+	if resp != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	}
+}
+
+// traceError marks the span of the context as failed
+func traceError(ctx context.Context, err error) {
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,145 @@
+package eventbrite
+
+import (
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+)
+
+// spanAttributes returns the attributes of the span by key
+func spanAttributes(s sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range s.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestTracing(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus codes.Code
+		wantAttrs  map[attribute.Key]attribute.Value
+	}{
+		{"success", "7", codes.Unset, map[attribute.Key]attribute.Value{
+			"eventbrite.operation":      attribute.StringValue("EventGet"),
+			"http.request.method":       attribute.StringValue(http.MethodGet),
+			"url.path":                  attribute.StringValue("/events/7"),
+			"eventbrite.event_id":       attribute.StringValue("7"),
+			"eventbrite.attempt":        attribute.IntValue(1),
+			"http.request.resend_count": attribute.IntValue(0),
+			"http.response.status_code": attribute.IntValue(http.StatusOK),
+		}},
+		{"retried", "retry", codes.Unset, map[attribute.Key]attribute.Value{
+			"eventbrite.attempt":        attribute.IntValue(2),
+			"http.request.resend_count": attribute.IntValue(1),
+			"http.response.status_code": attribute.IntValue(http.StatusOK),
+		}},
+		{"failed", "404", codes.Error, map[attribute.Key]attribute.Value{
+			"http.response.status_code": attribute.IntValue(http.StatusNotFound),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+			var attempts int32
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/404"):
+					w.WriteHeader(http.StatusNotFound)
+					w.Write([]byte(`{"error": "NOT_FOUND", "status_code": 404}`))
+				case strings.HasSuffix(r.URL.Path, "/retry") && atomic.AddInt32(&attempts, 1) == 1:
+					w.WriteHeader(http.StatusServiceUnavailable)
+				default:
+					w.Write([]byte(`{}`))
+				}
+			}, WithTracerProvider(tp), WithRetryPolicy(RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}))
+
+			ctx, parent := tp.Tracer("app").Start(context.Background(), "handler")
+			c.EventGet(ctx, tt.id)
+			parent.End()
+
+			spans := map[string]sdktrace.ReadOnlySpan{}
+			for _, s := range rec.Ended() {
+				spans[s.Name()] = s
+			}
+			call, ok := spans["eventbrite.EventGet"]
+			if !ok {
+				t.Fatalf("no span for the call, got %v", spans)
+			}
+			if call.Parent().SpanID() != parent.SpanContext().SpanID() || call.SpanKind() != trace.SpanKindClient {
+				t.Errorf("got a %s span child of %s, want a client span child of the context", call.SpanKind(), call.Parent().SpanID())
+			}
+			if wait, ok := spans["eventbrite.awaitRateLimiter"]; !ok || wait.Parent().SpanID() != call.SpanContext().SpanID() {
+				t.Error("no rate limiter span child of the span of the call")
+			}
+
+			attrs := spanAttributes(call)
+			for key, want := range tt.wantAttrs {
+				if got, ok := attrs[key]; !ok || got != want {
+					t.Errorf("got %s %v, want %v", key, got.Emit(), want.Emit())
+				}
+			}
+			if got := call.Status().Code; got != tt.wantStatus {
+				t.Errorf("got status %s, want %s", got, tt.wantStatus)
+			}
+			if tt.wantStatus == codes.Error && len(call.Events()) == 0 {
+				t.Error("error not recorded")
+			}
+		})
+	}
+}
+
+func TestStartSpanAttributes(t *testing.T) {
+	tests := []struct {
+		path string
+		page int
+		want map[attribute.Key]string
+	}{
+		{"/events/search/", 0, map[attribute.Key]string{}},
+		{"/events/7/ticket_classes/8/", 0, map[attribute.Key]string{"eventbrite.event_id": "7"}},
+		{"/users/me/owned_events/", 2, map[attribute.Key]string{"eventbrite.user_id": "me", "eventbrite.page": "2"}},
+		{"/organizers/3/events/", 0, map[attribute.Key]string{"eventbrite.organizer_id": "3"}},
+		{"/categories/", 0, map[attribute.Key]string{}},
+	}
+
+	rec := tracetest.NewSpanRecorder()
+	c := &Client{tracer: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)).Tracer(tracerName)}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.page > 0 {
+			ctx = withPage(ctx, tt.page, "")
+		}
+		_, span := c.startSpan(ctx, http.MethodGet, tt.path)
+		span.End()
+
+		spans := rec.Ended()
+		got := map[attribute.Key]string{}
+		for key, v := range spanAttributes(spans[len(spans)-1]) {
+			if strings.HasPrefix(string(key), "eventbrite.") && key != "eventbrite.operation" {
+				got[key] = v.Emit()
+			}
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got attributes %v, want %v", tt.path, got, tt.want)
+			continue
+		}
+		for key, want := range tt.want {
+			if got[key] != want {
+				t.Errorf("%s: got attributes %v, want %v", tt.path, got, tt.want)
+			}
+		}
+	}
+}
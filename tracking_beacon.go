@@ -13,16 +13,16 @@ type CreateTrackingBeaconRequest struct {
 	TrackingType string `json:"tracking_type" validate:"required"`
 
 	// The Event ID of the event that this tracking beacon will fire in
-	EventID string `json:"event_id"`
+	EventID string `json:"event_id,omitempty"`
 
 	// The User ID wherein the tracking beacon will be assigned to all of this user’s events
-	UserID string `json:"user_id"`
+	UserID string `json:"user_id,omitempty"`
 
 	// The Pixel ID given by the third party that will fire when a attendee lands on the page you are tracking
-	PixelID string `json:"pixel_id"`
+	PixelID string `json:"pixel_id,omitempty"`
 
 	// The additional pixel data needed to determine which page to fire the tracking pixel on
-	Triggers interface{} `json:"triggers"`
+	Triggers interface{} `json:"triggers,omitempty"`
 }
 
 // https://www.eventbrite.com/developer/v3/endpoints/tracking_beacons/#ebapi-id3
@@ -32,32 +32,32 @@ type UpdateTrackingBeaconRequest struct {
 	TrackingType string `json:"tracking_type" validate:"required"`
 
 	// The Event ID of the event that this tracking beacon will fire in
-	EventID string `json:"event_id"`
+	EventID string `json:"event_id,omitempty"`
 
 	// The User ID wherein the tracking beacon will be assigned to all of this user’s events
-	UserID string `json:"user_id"`
+	UserID string `json:"user_id,omitempty"`
 
 	// The Pixel ID given by the third party that will fire when a attendee lands on the page you are tracking
-	PixelID string `json:"pixel_id"`
+	PixelID string `json:"pixel_id,omitempty"`
 
 	// The additional pixel data needed to determine which page to fire the tracking pixel on
-	Triggers interface{} `json:"triggers"`
+	Triggers interface{} `json:"triggers,omitempty"`
 }
 
 // https://www.eventbrite.com/developer/v3/endpoints/tracking_beacons/#ebapi-id1
 type GetTrackingBeaconRequest struct {
 	// returned format
-	ReturnFmt string `json:"return_fmt"`
+	ReturnFmt string `json:"return_fmt,omitempty"`
 }
 
 type GetTrackingBeaconForEventRequest struct {
 	// returned format
-	ReturnFmt string `json:"return_fmt"`
+	ReturnFmt string `json:"return_fmt,omitempty"`
 }
 
 type GetTrackingBeaconForUserRequest struct {
 	// returned format
-	ReturnFmt string `json:"return_fmt"`
+	ReturnFmt string `json:"return_fmt,omitempty"`
 }
 
 // TrackingBeaconCreate makes a new tracking beacon. Returns an tracking_beacon as tracking_beacon. Either event_id
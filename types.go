@@ -6,29 +6,6 @@ import (
 	"time"
 )
 
-// When an error occurs during an API request, you’ll get a response with an error HTTP status
-// (in the 400 or 500 range), as well as a JSON response containing more information about the error.
-//
-// https://www.eventbrite.co.uk/developer/v3/api_overview/errors/#ebapi-errors
-type Error struct {
-	// The error key contains a constant string value for error - in this case, VENUE_AND_ONLINE - and
-	// is what you should key your error handling off of, as this string won’t change depending on locale
-	// or as we change the API over time
-	Err string `json:"error,omitempty"`
-	// The error_description key is for developer information only and will usually contain a more informative
-	// explanation for the error, should you be confused. You should not display this string to your users;
-	// it’s often very technical and may not be localized to their language
-	Description string `json:"error_description,omitempty"`
-	// The status_code value just mirrors the HTTP status code you got as part of the request. It’s there as
-	// a convenience if your HTTP library makes it very hard to get status codes, or has one error handler
-	// for all error codes
-	Status int `json:"status_code,omitempty"`
-}
-
-func (e Error) Error() string {
-	return fmt.Sprintf("Eventbrite API: [Status code - %d] %s", e.Status, e.Description)
-}
-
 // The ISO 3166 alpha-2 code of a country.
 type CountryCode string
 
@@ -41,6 +18,11 @@ type Currency struct {
 	Display  string       `json:"display,omitempty"`
 }
 
+// IsZero reports whether the amount is unset
+func (c Currency) IsZero() bool {
+	return c == Currency{}
+}
+
 type Date struct {
 	Time time.Time
 }
@@ -60,6 +42,11 @@ func (d Date) MarshalJSON() ([]byte, error) {
 	return []byte("\"" + d.Time.Format("2006-01-02") + "\""), nil
 }
 
+// IsZero reports whether the date is unset
+func (d Date) IsZero() bool {
+	return d.Time.IsZero()
+}
+
 type DateTime struct {
 	Time time.Time
 }
@@ -79,8 +66,15 @@ func (d DateTime) MarshalJSON() ([]byte, error) {
 	return []byte("\"" + d.Time.Format("2006-01-02T15:04:05Z") + "\""), nil
 }
 
+// IsZero reports whether the date and time is unset
+func (d DateTime) IsZero() bool {
+	return d.Time.IsZero()
+}
+
 // Timezone is an object with details about a timezone
 type Timezone struct {
+	RawFields
+
 	// Timezone id
 	ID string `json:"id,omitempty"`
 	// The timezone identifier as defined by the IANA Time Zone Database
@@ -100,6 +94,8 @@ type Pagination struct {
 	PageSize     int  `json:"page_size,omitempty"`
 	PageCount    int  `json:"page_count,omitempty"`
 	HasMoreItems bool `json:"has_more_items,omitempty"`
+	// The token to send to get the next page of endpoints paginated by continuation
+	Continuation string `json:"continuation,omitempty"`
 }
 
 // Returned for fields which represent HTML, like event names and descriptions.
@@ -127,6 +123,8 @@ type DatetimeTz struct {
 //
 // https://www.eventbrite.com/developer/v3/response_formats/system/#ebapi-countries
 type Country struct {
+	RawFields
+
 	// The country identifier as defined by the ISO 3166 standard
 	Code CountryCode `json:"code,omitempty"`
 	// The readable name of the country
@@ -137,6 +135,8 @@ type Country struct {
 //
 // https://www.eventbrite.com/developer/v3/response_formats/system/#ebapi-region
 type Region struct {
+	RawFields
+
 	// The associated country code to this region
 	CountryCode string `json:"country_code,omitempty"`
 	// The region identifier as defined by the ISO 3166 standard
@@ -149,6 +149,8 @@ type Region struct {
 //
 // https://www.eventbrite.com/developer/v3/response_formats/image/#ebapi-image
 type Image struct {
+	RawFields
+
 	// The image’s ID
 	ID string `json:"id,omitempty"`
 	// The URL of the image
@@ -159,6 +161,8 @@ type Image struct {
 //
 // https://www.eventbrite.com/developer/v3/response_formats/venue/#ebapi-venue
 type Venue struct {
+	RawFields
+
 	// The value name
 	Name string `json:"name,omitempty"`
 	// The address of the venue
@@ -199,6 +203,8 @@ type Address struct {
 //
 // https://www.eventbrite.com/developer/v3/response_formats/organizer/#ebapi-std:format-organizer
 type Organizer struct {
+	RawFields
+
 	// The organizer name
 	Name string `json:"name,omitempty"`
 	// The description of the organizer (may be very long and contain significant formatting)
@@ -211,6 +217,8 @@ type Organizer struct {
 //
 // https://www.eventbrite.com/developer/v3/response_formats/event/#ebapi-category
 type Category struct {
+	RawFields
+
 	// Category ID
 	ID string `json:"id,omitempty"`
 	// he category name
@@ -228,6 +236,8 @@ type Category struct {
 //
 // https://www.eventbrite.com/developer/v3/response_formats/event/#ebapi-subcategory
 type SubCategory struct {
+	RawFields
+
 	// Subcategory ID
 	ID string `json:"id,omitempty"`
 	// The category name
@@ -238,6 +248,8 @@ type SubCategory struct {
 
 // https://www.eventbrite.com/developer/v3/endpoints/events/#ebapi-get-events-id-display-settings
 type EventSettings struct {
+	RawFields
+
 	// Whether to display the start date on the event listing
 	ShowStartDate bool `json:"display_settings.show_start_date,omitempty"`
 	// Whether to display the end date on the event listing
@@ -264,6 +276,8 @@ type EventSettings struct {
 //
 // https://www.eventbrite.com/developer/v3/response_formats/event/#ebapi-ticket-class
 type TicketClass struct {
+	RawFields
+
 	ID string `json:"id,omitempty"`
 	// The ticket class’ name
 	Name string `json:"name,omitempty"`
@@ -317,6 +331,8 @@ type TicketClass struct {
 //
 // https://www.eventbrite.com/developer/v3/response_formats/tracking_beacon/#ebapi-tracking-beacon
 type TrackingBeacon struct {
+	RawFields
+
 	// The tracking beacon id
 	ID string
 	// The tracking beacon third party type. Allowed types are: Facebook Pixel,
@@ -334,6 +350,8 @@ type TrackingBeacon struct {
 
 // An object representing a single webhook associated with the account
 type Webhook struct {
+	RawFields
+
 	// The url that the webhook will send data to when it is triggered
 	EndpointUrl string `json:"endpoint_url,omitempty"`
 	// One or any combination of actions that will cause this webhook to fire
@@ -343,6 +361,8 @@ type Webhook struct {
 // Attendee is an object representing the details of one or more people coming to the event
 // Attendee objects are considered private and are only available to the event owner
 type Attendee struct {
+	RawFields
+
 	// When the attendee was created (order placed)
 	Created DateTime `json:"created,omitempty"`
 	// When the attendee was last changed
@@ -373,11 +393,11 @@ type Attendee struct {
 	Status string `json:"status,omitempty"`
 	// The event id that this attendee is attending
 	EventID string `json:"event_id,omitempty"`
-	// The event this attendee is attending
+	// The event this attendee is attending, populated with ExpandEvent
 	Event *Event `json:"event,omitempty"`
 	// The order id this attendee is part of
 	OrderID string `json:"order_id,omitempty"`
-	// The order this attendee is part of
+	// The order this attendee is part of, populated with ExpandOrder
 	Order *Order `json:"order,omitempty"`
 	// The guestlist id for this attendee. If this is null it means that this is not a guest
 	GuestListID string `json:"guestlist_id,omitempty"`
@@ -487,3 +507,51 @@ type AttendeeTeam struct {
 	// The event the team is part of
 	EventID string `json:"event_id,omitempty"`
 }
+
+// PublishResult is returned by the endpoints publishing an event or a series
+type PublishResult struct {
+	RawFields
+
+	Published bool `json:"published"`
+}
+
+// UnpublishResult is returned by the endpoints unpublishing an event or a series
+type UnpublishResult struct {
+	RawFields
+
+	Unpublished bool `json:"unpublished"`
+}
+
+// CancelResult is returned by the endpoints canceling an event or a series
+type CancelResult struct {
+	RawFields
+
+	Canceled bool `json:"canceled"`
+}
+
+// DeleteResult is returned by the endpoints deleting an object, i.e. {"deleted": true}
+type DeleteResult struct {
+	RawFields
+
+	Deleted bool `json:"deleted"`
+}
+
+// CreateResult is returned by the endpoints adding to a list rather than creating an
+// object, i.e. {"created": true}
+type CreateResult struct {
+	RawFields
+
+	Created bool `json:"created"`
+}
+
+// Bool returns a pointer to b, to set the optional bool fields of a request, such as
+// EventUpdateRequest.Listed, including to false
+func Bool(b bool) *bool {
+	return &b
+}
+
+// Int returns a pointer to n, to set the optional int fields of a request, such as
+// DiscountUpdateRequest.QuantityAvailable, including to 0
+func Int(n int) *int {
+	return &n
+}
@@ -9,6 +9,8 @@ import (
 //
 // https://www.eventbrite.com/developer/v3/response_formats/user/#ebapi-std:format-user
 type User struct {
+	RawFields
+
 	ID string `json:"id"`
 	// The user’s name. Use this in preference to first_name/last_name if possible for forward compatibility with non-Western names
 	Name string `json:"name"`
@@ -21,6 +23,8 @@ type User struct {
 }
 
 type Contact struct {
+	RawFields
+
 	// The contact’s name. Use this in preference to first_name/last_name if possible for
 	// forward compatability with non-Western names
 	Name string `json:"name"`
@@ -36,6 +40,8 @@ type Contact struct {
 
 // https://www.eventbrite.com/developer/v3/response_formats/user/#ebapi-contact-list
 type ContactList struct {
+	RawFields
+
 	// The name of the contact list
 	Name string `json:"name"`
 	// The user who owns this contact list
@@ -54,10 +60,10 @@ type Email struct {
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-id1
 type GetUserOrdersRequest struct {
 	// Only return resource changed on or after the time given
-	ChangedSince string `json:"changed_since"`
+	ChangedSince string `json:"changed_since,omitempty"`
 	// Limits results to either past or current & future events / orders.
 	// (Valid choices are: all, past, or current_future)
-	TimeFilter string `json:"time_filter"`
+	TimeFilter string `json:"time_filter,omitempty"`
 }
 
 // An assortment is a package/pricing plan associated with an Eventbrite organizer.
@@ -66,6 +72,8 @@ type GetUserOrdersRequest struct {
 //
 // https://www.eventbrite.com/developer/v3/response_formats/assortments/#ebapi-fields
 type Assortment struct {
+	RawFields
+
 	// The assortment plan associated with this user
 	Plan string `json:"plan"`
 }
@@ -74,43 +82,43 @@ type Assortment struct {
 type GetUserOrganizersRequest struct {
 	//     True: Will hide organizers flagged as “unsaved” False: Will show organizers
 	// regardless of unsaved flag (Default value)
-	HideUnsaved bool `json:"hide_unsaved"`
+	HideUnsaved bool `json:"hide_unsaved,omitempty"`
 }
 
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-id5
 type GetUserOwnedEvents struct {
 	// How to order the results (Valid choices are: start_asc, start_desc, created_asc,
 	// created_desc, name_asc, or name_desc)
-	OrderBy string `json:"order_by"`
+	OrderBy string `json:"order_by,omitempty"`
 	// True: Will show parent of a serie instead of children False: Will show children of a serie (Default value)
-	ShowSeriesParent bool `json:"show_series_parent"`
+	ShowSeriesParent bool `json:"show_series_parent,omitempty"`
 	// Filter by events with a specific status set. This should be a comma delimited string of status.
 	// Valid status: all, draft, live, canceled, started, ended.
-	Status string `json:"status"`
+	Status string `json:"status,omitempty"`
 }
 
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-id7
 type GetUserEvents struct {
 	// Filter event results by name
-	NameFilter string `json:"name_filter"`
+	NameFilter string `json:"name_filter,omitempty"`
 	// Filter event results by currency
-	CurrencyFilter string `json:"currency_filter"`
+	CurrencyFilter string `json:"currency_filter,omitempty"`
 	// How to order the results (Valid choices are: start_asc, start_desc, created_asc,
 	// created_desc, name_asc, or name_desc)
-	OrderBy string `json:"order_by"`
+	OrderBy string `json:"order_by,omitempty"`
 	// True: Will show parent of a serie instead of children False: Will show children of a serie (Default value)
-	ShowSeriesParent bool `json:"show_series_parent"`
+	ShowSeriesParent bool `json:"show_series_parent,omitempty"`
 	// Filter by events with a specific status set. This should be a comma delimited string of status.
 	// Valid status: all, draft, live, canceled, started, ended
-	Status string `json:"status"`
+	Status string `json:"status,omitempty"`
 	// Filter event results by event_group_id
-	EventGroupID string `json:"event_group_id"`
+	EventGroupID string `json:"event_group_id,omitempty"`
 	// Number of records in each page
-	PageSize int `json:"page_size"`
+	PageSize int `json:"page_size,omitempty"`
 	// Limits results to either past or current & future events / orders. (Valid choices are: all, past, or current_future
-	TimeFilter string `json:"time_filter"`
+	TimeFilter string `json:"time_filter,omitempty"`
 	// Filter event results by venue IDs
-	VenueFilter []interface{} `json:"venue_filter"`
+	VenueFilter []interface{} `json:"venue_filter,omitempty"`
 }
 
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-id9
@@ -130,18 +138,18 @@ type CreateOrganizationEventRequest struct {
 	//    End time timezone (Olson format)
 	EventEndTimezone string `json:"event.end.timezone" validate:"required"`
 	// Whether the start date should be hidden
-	EventHideStartDate bool `json:"event.hide_start_date"`
+	EventHideStartDate *bool `json:"event.hide_start_date,omitempty"`
 	// Whether the end date should be hidden
-	EventHideEndDate bool `json:"event.hide_end_date"`
+	EventHideEndDate *bool `json:"event.hide_end_date,omitempty"`
 	// Event currency (3 letter code)
 	EventCurrency string `json:"event.currency" validate:"required"`
 	// The ID of a previously-created venue to associate with this event. You can omit this field or
 	// set it to null if you set online_event.
 	VenueId string `json:"event.venue_id"`
 	// Is the event online-only (no venue)?
-	OnlineEvent bool `json:"event.online_event"`
+	OnlineEvent *bool `json:"event.online_event,omitempty"`
 	// If the event is publicly listed and searchable. Defaults to True.
-	Listed bool `json:"event.listed"`
+	Listed *bool `json:"event.listed,omitempty"`
 	// The logo for the event
 	LogoId string `json:"event.logo_id"`
 	// The category (vertical) of the event
@@ -151,17 +159,17 @@ type CreateOrganizationEventRequest struct {
 	// The format (general type) of the event
 	FormatId string `json:"event.format_id"`
 	// If users can share the event on social media
-	Sharable bool `json:"event.shareable"`
+	Sharable *bool `json:"event.shareable,omitempty"`
 	// Only invited users can see the event page
-	InviteOnly bool `json:"event.invite_only"`
+	InviteOnly *bool `json:"event.invite_only,omitempty"`
 	// Password needed to see the event in unlisted mode
 	Password string `json:"event.password"`
 	// Set specific capacity (if omitted, sums ticket capacities)
 	Capacity int `json:"event.capacity"`
 	// If the remaining number of tickets is publicly visible on the event page
-	ShowRemaining bool `json:"event.show_remaining"`
+	ShowRemaining *bool `json:"event.show_remaining,omitempty"`
 	// If the event is reserved seating
-	IsReservedSeating bool `json:"event.is_reserved_seating"`
+	IsReservedSeating *bool `json:"event.is_reserved_seating,omitempty"`
 	// Source of the event (defaults to API)
 	Source string `json:"event.source"`
 }
@@ -206,17 +214,19 @@ type CreateOrganizationVenueRequest struct {
 type UserEventAttendeesRequest struct {
 	// Limits results to either confirmed attendees or cancelled/refunded/etc. attendees
 	// (Valid choices are: attending, or not_attending)
-	Status string `json:"status"`
+	Status string `json:"status,omitempty"`
 	// Only return resource changed on or after the time given
-	ChangedSince string `json:"changed_since"`
+	ChangedSince string `json:"changed_since,omitempty"`
 }
 
 // UserEventAttendeesResponse is the response structure to get a user owned event attendees
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/users/#ebapi-get-users-id-owned-event-attendees
 type UserEventAttendeesResponse struct {
+	RawFields
+
 	Pagination Pagination `json:"pagination"`
-	Attendees  []Attendee `json:"attendee"`
+	Attendees  []Attendee `json:"attendees"`
 }
 
 // UserEventOrders is the request structure to get all order placed under
@@ -226,15 +236,17 @@ type UserEventAttendeesResponse struct {
 type UserEventOrders struct {
 	// Limits results to either past or current & future events / orders.
 	// (Valid choices are: all, past, or current_future)
-	TimeFilter string `json:"time_filter"`
+	TimeFilter string `json:"time_filter,omitempty"`
 	// Only return resource changed on or after the time given
-	ChangedSince string `json:"changed_since"`
+	ChangedSince string `json:"changed_since,omitempty"`
 }
 
 // GetUserOrdersResult is the response structure for user orders
 //
 // https://www.eventbrite.co.uk/developer/v3/endpoints/users/#ebapi-get-users-id-orders
 type UserOrdersResult struct {
+	RawFields
+
 	Pagination Pagination `json:"pagination"`
 	Orders     []Order    `json:"orders"`
 }
@@ -245,11 +257,13 @@ type UserOrdersResult struct {
 type UserOrganizerRequest struct {
 	// True: Will hide organizers flagged as “unsaved” False: Will show organizers
 	// regardless of unsaved flag (Default value)
-	HideUnsaved bool `json:"hide_unsaved"`
+	HideUnsaved bool `json:"hide_unsaved,omitempty"`
 }
 
 // UserOrganizerResponse is the response structure for all organizer objects that are owned by the user
 type UserOrganizerResponse struct {
+	RawFields
+
 	Pagination Pagination  `json:"pagination"`
 	Organizers []Organizer `json:"organizers"`
 }
@@ -260,67 +274,83 @@ type UserOrganizerResponse struct {
 type UserOwnedEventsRequest struct {
 	// How to order the results (Valid choices are: start_asc, start_desc, created_asc,
 	// created_desc, name_asc, or name_desc)
-	OrderBy string `json:"order_by"`
+	OrderBy string `json:"order_by,omitempty"`
 	// True: Will show parent of a serie instead of children False: Will show children of a serie (Default value)
-	ShowSeriesParent bool `json:"show_series_parent"`
+	ShowSeriesParent bool `json:"show_series_parent,omitempty"`
 	// Filter by events with a specific status set. This should be a comma delimited string of status.
 	// Valid status: all, draft, live, canceled, started, ended
-	Status string `json:"status"`
+	Status string `json:"status,omitempty"`
 }
 
 // UserOwnedEventResponse is the response structure to get user owned events
 type UserOwnedEventResponse struct {
+	RawFields
+
 	Pagination Pagination `json:"pagination"`
 	Events     []Event    `json:"events"`
 }
 
+// UserEventsRequest is the request structure to get the events the user has access to
+//
+// https://www.eventbrite.co.uk/developer/v3/endpoints/users/#ebapi-get-users-id-events
 type UserEventsRequest struct {
-}
-
-type UserEventsResponse struct {
 	// Filter event results by name
-	NameFilter string `json:"name_filter"`
+	NameFilter string `json:"name_filter,omitempty"`
 	// Filter event results by currency
-	CurrencyFilter string `json:"currency_filter"`
+	CurrencyFilter string `json:"currency_filter,omitempty"`
 	// How to order the results (Valid choices are: start_asc, start_desc, created_asc, created_desc, name_asc, or name_desc)
-	OrderBy string `json:"order_by"`
+	OrderBy string `json:"order_by,omitempty"`
 	// True: Will show parent of a serie instead of children False: Will show children of a serie (Default value)
-	ShowSeriesParent bool `json:"show_series_parent"`
+	ShowSeriesParent bool `json:"show_series_parent,omitempty"`
 	// Filter by events with a specific status set. This should be a comma delimited string of
 	// status. Valid status: all, draft, live, canceled, started, ended.
-	Status string `json:"status"`
+	Status string `json:"status,omitempty"`
 	// Filter event results by event_group_id
-	EventGroupID string `json:"event_group_id"`
+	EventGroupID string `json:"event_group_id,omitempty"`
 	// Number of records in each page.
-	PageSize int `json:"page_size"`
+	PageSize int `json:"page_size,omitempty"`
 	// Limits results to either past or current & future events / orders. (Valid choices are: all, past, or current_future)
-	TimeFilter string `json:"time_filter"`
+	TimeFilter string `json:"time_filter,omitempty"`
 	// Filter event results by venue IDs
-	VenueFilter []interface{} `json:"venue_filter"`
+	VenueFilter []interface{} `json:"venue_filter,omitempty"`
+}
+
+// UserEventsResponse is the response structure to get the events the user has access to
+type UserEventsResponse struct {
+	RawFields
+
+	Pagination Pagination `json:"pagination"`
+	Events     []Event    `json:"events"`
 }
 
 // UserVenuesResponse is the response structure to get user owned venues
 type UserVenuesResponse struct {
+	RawFields
+
 	Pagination Pagination `json:"pagination"`
 	Venues     []Venue    `json:"venues"`
 }
 
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-id17
 type UserEventOrdersRequest struct {
-	Status        string   `json:"status"`
-	OnlyEmails    []string `json:"only_emails"`
-	ExcludeEmails []string `json:"exclude_emails"`
-	ChangedSince  DateTime `json:"datetime"`
+	Status        string   `json:"status,omitempty"`
+	OnlyEmails    []string `json:"only_emails,omitempty"`
+	ExcludeEmails []string `json:"exclude_emails,omitempty"`
+	ChangedSince  DateTime `json:"changed_since,omitempty"`
 }
 
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-get-users-id-owned-event-orders
 type UserEventOrdersResponse struct {
+	RawFields
+
 	Pagination Pagination `json:"pagination"`
 	Orders     []Order    `json:"orders"`
 }
 
 // UserContactListsResponse is the response structure to get user contact lists
 type UserContactListsResponse struct {
+	RawFields
+
 	Pagination  Pagination    `json:"pagination"`
 	ContactList []ContactList `json:"contact_lists"`
 }
@@ -337,9 +367,9 @@ type UserAddContactListContactRequest struct {
 	// Contact’s email address
 	Email string `json:"email" validate:"required"`
 	// Contact’s first name (or full name)
-	FirstName string `json:"first_name"`
+	FirstName string `json:"first_name,omitempty"`
 	// Contact’s last name
-	LastName string `json:"last_name"`
+	LastName string `json:"last_name,omitempty"`
 }
 
 type UserDeleteContactListContactRequest struct {
@@ -348,6 +378,8 @@ type UserDeleteContactListContactRequest struct {
 }
 
 type UserContactListContacts struct {
+	RawFields
+
 	Pagination Pagination `json:"pagination"`
 	Contacts   []Contact  `json:"contacts"`
 }
@@ -355,40 +387,48 @@ type UserContactListContacts struct {
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-id35
 type UserBookmarksRequest struct {
 	// Optional bookmark list id to fetch all bookmarks from
-	BookmarkListID string `json:"bookmark_list_id"`
+	BookmarkListID string `json:"bookmark_list_id,omitempty"`
 }
 
 type UserBookmarksResponse struct {
+	RawFields
+
 	Pagination Pagination `json:"pagination"`
 	Events     []Event    `json:"events"`
 }
 
 type UserSaveBookmarkRequest struct {
 	// Event id to bookmark for the user
-	EventID int `json:"event_id"`
+	EventID int `json:"event_id,omitempty"`
 	// Event ids to batch bookmark for the user
-	EventIDs []string `json:"event_ids"`
+	EventIDs []string `json:"event_ids,omitempty"`
 	// Optional Bookmark list id to save the bookmark(s) to
-	BookmarkListID string `json:"bookmark_list_id"`
+	BookmarkListID string `json:"bookmark_list_id,omitempty"`
 }
 
 type UserUnSaveBookmarkRequest struct {
 	// Event id to bookmark for the user
-	EventID int `json:"event_id"`
+	EventID int `json:"event_id,omitempty"`
 	// Event ids to batch bookmark for the user
-	EventIDs []string `json:"event_ids"`
+	EventIDs []string `json:"event_ids,omitempty"`
 	// Optional Bookmark list id to save the bookmark(s) to
-	BookmarkListID string `json:"bookmark_list_id"`
+	BookmarkListID string `json:"bookmark_list_id,omitempty"`
 }
 
+// UserTicketGroupsRequest is the request structure to get the ticket groups of an organization
+//
+// https://www.eventbrite.co.uk/developer/v3/endpoints/users/#ebapi-get-users-id-ticket-groups
 type UserTicketGroupsRequest struct {
 	//     Limits results to groups with the specific status (Valid choices are: live, archived, deleted, or all)
 	Status string `json:"status"`
 }
 
+// UserTicketGroupResponse is the response structure to get the ticket groups of an organization
 type UserTicketGroupResponse struct {
-	Pagination   Pagination `json:"pagination"`
-	TicketGroups []*TicketGroup
+	RawFields
+
+	Pagination   Pagination    `json:"pagination"`
+	TicketGroups []TicketGroup `json:"ticket_groups"`
 }
 
 type UserSetAssortmentRequest struct {
@@ -409,11 +449,21 @@ func (c *Client) User(ctx context.Context, id string) (*User, error) {
 // UserOrders returns a paginated response of orders, under the key orders, of all orders
 // the user has placed (i.e. where the user was the person buying the tickets).
 //
+// Valid expansions: event, attendees, refund_requests.
+//
 // https://www.eventbrite.co.uk/developer/v3/endpoints/users/#ebapi-get-users-id-orders
-func (c *Client) UserOrders(ctx context.Context, id string, req *UserEventOrders) (*UserOrdersResult, error) {
+func (c *Client) UserOrders(ctx context.Context, id string, req *UserEventOrders, expand ...Expansion) (*UserOrdersResult, error) {
 	r := new(UserOrdersResult)
 
-	return r, c.getJSON(ctx, fmt.Sprintf("/users/%s/orders/", id), req, r)
+	return r, c.getJSON(withExpansions(ctx, expand), fmt.Sprintf("/users/%s/orders/", id), req, r)
+}
+
+// UserOrdersIterator returns an iterator walking all the pages of UserOrders
+func (c *Client) UserOrdersIterator(ctx context.Context, id string, req *UserEventOrders, expand ...Expansion) *Iterator[Order] {
+	return newIterator(ctx, func(ctx context.Context) ([]Order, Pagination, error) {
+		r, err := c.UserOrders(ctx, id, req, expand...)
+		return r.Orders, r.Pagination, err
+	})
 }
 
 // UserOrganizers returns a paginated response of organizer objects that are owned by the user.
@@ -425,13 +475,32 @@ func (c *Client) UserOrganizers(ctx context.Context, id string, req *UserOrganiz
 	return r, c.getJSON(ctx, fmt.Sprintf("/users/%s/organizers/", id), req, r)
 }
 
+// UserOrganizersIterator returns an iterator walking all the pages of UserOrganizers
+func (c *Client) UserOrganizersIterator(ctx context.Context, id string, req *UserOrganizerRequest) *Iterator[Organizer] {
+	return newIterator(ctx, func(ctx context.Context) ([]Organizer, Pagination, error) {
+		r, err := c.UserOrganizers(ctx, id, req)
+		return r.Organizers, r.Pagination, err
+	})
+}
+
 // UserOrganizers returns a paginated response of organizer objects that are owned by the user.
 //
+// Valid expansions: venue, organizer, format, category, subcategory, ticket_classes,
+// ticket_availability, bookmark_info, refund_policy.
+//
 // https://www.eventbrite.co.uk/developer/v3/endpoints/users/#ebapi-get-users-id-organizers
-func (c *Client) UserOwnedEvents(ctx context.Context, id string, req *UserOwnedEventsRequest) (*UserOwnedEventResponse, error) {
+func (c *Client) UserOwnedEvents(ctx context.Context, id string, req *UserOwnedEventsRequest, expand ...Expansion) (*UserOwnedEventResponse, error) {
 	r := new(UserOwnedEventResponse)
 
-	return r, c.getJSON(ctx, fmt.Sprintf("/users/%s/owned_events/", id), req, r)
+	return r, c.getJSON(withExpansions(ctx, expand), fmt.Sprintf("/users/%s/owned_events/", id), req, r)
+}
+
+// UserOwnedEventsIterator returns an iterator walking all the pages of UserOwnedEvents
+func (c *Client) UserOwnedEventsIterator(ctx context.Context, id string, req *UserOwnedEventsRequest, expand ...Expansion) *Iterator[Event] {
+	return newIterator(ctx, func(ctx context.Context) ([]Event, Pagination, error) {
+		r, err := c.UserOwnedEvents(ctx, id, req, expand...)
+		return r.Events, r.Pagination, err
+	})
 }
 
 // UserEvents returns a paginated response of events, under the key events, of all events the user has access to
@@ -443,35 +512,71 @@ func (c *Client) UserEvents(ctx context.Context, id string, req UserEventsReques
 	return r, c.getJSON(ctx, fmt.Sprintf("/users/%s/events/", id), req, r)
 }
 
+// UserEventsIterator returns an iterator walking all the pages of UserEvents
+func (c *Client) UserEventsIterator(ctx context.Context, id string, req UserEventsRequest) *Iterator[Event] {
+	return newIterator(ctx, func(ctx context.Context) ([]Event, Pagination, error) {
+		r, err := c.UserEvents(ctx, id, req)
+		return r.Events, r.Pagination, err
+	})
+}
+
 // UserVenues returns a paginated response of venue objects that are owned by the user
 func (c *Client) UserVenues(ctx context.Context, id string) (*UserVenuesResponse, error) {
 	r := new(UserVenuesResponse)
 
-	return r, c.getJSON(ctx, fmt.Sprintf("/users/%s/events/", id), nil, r)
+	return r, c.getJSON(ctx, fmt.Sprintf("/users/%s/venues/", id), nil, r)
+}
+
+// UserVenuesIterator returns an iterator walking all the pages of UserVenues
+func (c *Client) UserVenuesIterator(ctx context.Context, id string) *Iterator[Venue] {
+	return newIterator(ctx, func(ctx context.Context) ([]Venue, Pagination, error) {
+		r, err := c.UserVenues(ctx, id)
+		return r.Venues, r.Pagination, err
+	})
 }
 
 // UserEventAttendees returns a paginated response of attendees, under the key attendees, of attendees visiting
 // any of the events the user owns (events that would be returned from /users/:id/owned_events/)
 //
+// Valid expansions: event, order, promotional_code.
+//
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-get-users-id-owned-event-attendees
-func (c *Client) UserEventAttendees(ctx context.Context, id string, request *UserEventAttendeesRequest) (*UserEventAttendeesResponse, error) {
+func (c *Client) UserEventAttendees(ctx context.Context, id string, request *UserEventAttendeesRequest, expand ...Expansion) (*UserEventAttendeesResponse, error) {
 	r := new(UserEventAttendeesResponse)
 
-	return r, c.getJSON(ctx, fmt.Sprintf("/users/%s/owned_event_attendees/", id), request, r)
+	return r, c.getJSON(withExpansions(ctx, expand), fmt.Sprintf("/users/%s/owned_event_attendees/", id), request, r)
+
+}
 
+// UserEventAttendeesIterator returns an iterator walking all the pages of UserEventAttendees
+func (c *Client) UserEventAttendeesIterator(ctx context.Context, id string, request *UserEventAttendeesRequest, expand ...Expansion) *Iterator[Attendee] {
+	return newIterator(ctx, func(ctx context.Context) ([]Attendee, Pagination, error) {
+		r, err := c.UserEventAttendees(ctx, id, request, expand...)
+		return r.Attendees, r.Pagination, err
+	})
 }
 
 // UserEventOrders returns a paginated response of orders, under the key orders, of orders placed against any of
 // the events the user owns (events that would be returned from /users/:id/owned_events/)
 //
+// Valid expansions: event, attendees, refund_requests.
+//
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-get-users-id-owned-event-orders
-func (c *Client) UserEventOrders(ctx context.Context, id string, request *UserEventOrdersRequest) (*UserEventOrdersResponse, error) {
+func (c *Client) UserEventOrders(ctx context.Context, id string, request *UserEventOrdersRequest, expand ...Expansion) (*UserEventOrdersResponse, error) {
 	r := new(UserEventOrdersResponse)
 
-	return r, c.getJSON(ctx, fmt.Sprintf("/users/%s/owned_event_orders/", id), request, r)
+	return r, c.getJSON(withExpansions(ctx, expand), fmt.Sprintf("/users/%s/owned_event_orders/", id), request, r)
 
 }
 
+// UserEventOrdersIterator returns an iterator walking all the pages of UserEventOrders
+func (c *Client) UserEventOrdersIterator(ctx context.Context, id string, request *UserEventOrdersRequest, expand ...Expansion) *Iterator[Order] {
+	return newIterator(ctx, func(ctx context.Context) ([]Order, Pagination, error) {
+		r, err := c.UserEventOrders(ctx, id, request, expand...)
+		return r.Orders, r.Pagination, err
+	})
+}
+
 // UserContactLists returns a list of contact_list that the user owns as the key contact_lists
 //
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-get-users-id-contact-lists
@@ -481,6 +586,14 @@ func (c *Client) UserContactLists(ctx context.Context, id string) (*UserContactL
 	return r, c.getJSON(ctx, fmt.Sprintf("/users/%s/contact_lists/", id), nil, r)
 }
 
+// UserContactListsIterator returns an iterator walking all the pages of UserContactLists
+func (c *Client) UserContactListsIterator(ctx context.Context, id string) *Iterator[ContactList] {
+	return newIterator(ctx, func(ctx context.Context) ([]ContactList, Pagination, error) {
+		r, err := c.UserContactLists(ctx, id)
+		return r.ContactList, r.Pagination, err
+	})
+}
+
 // UserCreateContactList makes a new contact_list for the user and returns it as contact_list
 //
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-post-users-id-contact-lists
@@ -511,8 +624,8 @@ func (c *Client) UserUpdateContactList(ctx context.Context, id, contactListID st
 // UserDeleteContactList deletes the contact list. Returns {"deleted": true}
 //
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-delete-users-id-contact-lists-contact-list-id
-func (c *Client) UserDeleteContactList(ctx context.Context, id, contactListID string) (interface{}, error) {
-	var r interface{}
+func (c *Client) UserDeleteContactList(ctx context.Context, id, contactListID string) (*DeleteResult, error) {
+	r := new(DeleteResult)
 
 	return r, c.deleteJSON(ctx, fmt.Sprintf("/users/%s/contact_lists/%s/", id, contactListID), r)
 }
@@ -523,7 +636,15 @@ func (c *Client) UserDeleteContactList(ctx context.Context, id, contactListID st
 func (c *Client) UserListContactContacts(ctx context.Context, id, contactListID string) (*UserContactListContacts, error) {
 	r := new(UserContactListContacts)
 
-	return r, c.postJSON(ctx, fmt.Sprintf("/users/%s/contact_lists/%s/contacts/", id, contactListID), nil, r)
+	return r, c.getJSON(ctx, fmt.Sprintf("/users/%s/contact_lists/%s/contacts/", id, contactListID), nil, r)
+}
+
+// UserListContactContactsIterator returns an iterator walking all the pages of UserListContactContacts
+func (c *Client) UserListContactContactsIterator(ctx context.Context, id, contactListID string) *Iterator[Contact] {
+	return newIterator(ctx, func(ctx context.Context) ([]Contact, Pagination, error) {
+		r, err := c.UserListContactContacts(ctx, id, contactListID)
+		return r.Contacts, r.Pagination, err
+	})
 }
 
 // UserContactListContacts adds a new contact to the contact list. Returns {"created": true}
@@ -540,8 +661,8 @@ func (c *Client) UserListContactAddContacts(ctx context.Context, id, contactList
 // There is no way to update entries in the list; just delete the old one and add the updated version.
 //
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-get-users-id-contact-lists-contact-list-id-contacts
-func (c *Client) UserListContactDeleteContacts(ctx context.Context, id, contactListID string) (interface{}, error) {
-	r := new(UserContactListContacts)
+func (c *Client) UserListContactDeleteContacts(ctx context.Context, id, contactListID string) (*DeleteResult, error) {
+	r := new(DeleteResult)
 
 	return r, c.deleteJSON(ctx, fmt.Sprintf("/users/%s/contact_lists/%s/contacts/", id, contactListID), r)
 }
@@ -550,31 +671,42 @@ func (c *Client) UserListContactDeleteContacts(ctx context.Context, id, contactL
 // In order to update the saved events list, the user must unsave or save each event.
 // A user is authorized to only see his/her saved events.
 //
+// Valid expansions: venue, organizer, format, category, subcategory, ticket_classes,
+// ticket_availability, bookmark_info, refund_policy.
+//
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-get-users-id-bookmarks
-func (c *Client) UserBookmarks(ctx context.Context, id string, req *UserBookmarksRequest) (*UserBookmarksResponse, error) {
+func (c *Client) UserBookmarks(ctx context.Context, id string, req *UserBookmarksRequest, expand ...Expansion) (*UserBookmarksResponse, error) {
 	r := new(UserBookmarksResponse)
 
-	return r, c.getJSON(ctx, fmt.Sprintf("/users/%s/bookmarks/", id), req, r)
+	return r, c.getJSON(withExpansions(ctx, expand), fmt.Sprintf("/users/%s/bookmarks/", id), req, r)
+}
+
+// UserBookmarksIterator returns an iterator walking all the pages of UserBookmarks
+func (c *Client) UserBookmarksIterator(ctx context.Context, id string, req *UserBookmarksRequest, expand ...Expansion) *Iterator[Event] {
+	return newIterator(ctx, func(ctx context.Context) ([]Event, Pagination, error) {
+		r, err := c.UserBookmarks(ctx, id, req, expand...)
+		return r.Events, r.Pagination, err
+	})
 }
 
 // UserSaveBookmarks adds a new bookmark for the user. Returns {"created": true}.
 // A user is only authorized to save his/her own events.
 //
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-post-users-id-bookmarks-save
-func (c *Client) UserSaveBookmarks(ctx context.Context, id string, req *UserSaveBookmarkRequest) (interface{}, error) {
-	var v interface{}
+func (c *Client) UserSaveBookmarks(ctx context.Context, id string, req *UserSaveBookmarkRequest) (*CreateResult, error) {
+	v := new(CreateResult)
 
-	return v, c.getJSON(ctx, fmt.Sprintf("/users/%s/bookmarks/save", id), req, v)
+	return v, c.postJSON(ctx, fmt.Sprintf("/users/%s/bookmarks/save/", id), req, v)
 }
 
 // UserUnSaveBookmarks removes the specified bookmark from the event for the user. Returns {"deleted": true}.
 // A user is only authorized to unsave his/her own events.
 //
 // https://www.eventbrite.com/developer/v3/endpoints/users/#ebapi-post-users-id-bookmarks-unsave
-func (c *Client) UserUnSaveBookmarks(ctx context.Context, id string, req *UserUnSaveBookmarkRequest) (interface{}, error) {
-	var v interface{}
+func (c *Client) UserUnSaveBookmarks(ctx context.Context, id string, req *UserUnSaveBookmarkRequest) (*DeleteResult, error) {
+	v := new(DeleteResult)
 
-	return v, c.getJSON(ctx, fmt.Sprintf("/users/%s/bookmarks/unsave", id), req, v)
+	return v, c.postJSON(ctx, fmt.Sprintf("/users/%s/bookmarks/unsave/", id), req, v)
 }
 
 // UserAssortments retrieve the assortment for the user
@@ -594,3 +726,20 @@ func (c *Client) UserSetAssortments(ctx context.Context, id string, req *UserSet
 
 	return a, c.postJSON(ctx, fmt.Sprintf("/users/%s/assortment/", id), req, a)
 }
+
+// UserTicketGroups returns a paginated response of the ticket groups of the organization of the user
+//
+// https://www.eventbrite.co.uk/developer/v3/endpoints/users/#ebapi-get-users-id-ticket-groups
+func (c *Client) UserTicketGroups(ctx context.Context, id string, req *UserTicketGroupsRequest) (*UserTicketGroupResponse, error) {
+	r := new(UserTicketGroupResponse)
+
+	return r, c.getJSON(ctx, fmt.Sprintf("/users/%s/ticket_groups/", id), req, r)
+}
+
+// UserTicketGroupsIterator returns an iterator walking all the pages of UserTicketGroups
+func (c *Client) UserTicketGroupsIterator(ctx context.Context, id string, req *UserTicketGroupsRequest) *Iterator[TicketGroup] {
+	return newIterator(ctx, func(ctx context.Context) ([]TicketGroup, Pagination, error) {
+		r, err := c.UserTicketGroups(ctx, id, req)
+		return r.TicketGroups, r.Pagination, err
+	})
+}
@@ -9,29 +9,29 @@ import (
 // https://www.eventbrite.com/developer/v3/endpoints/venues/#ebapi-id1
 type UpdateVenueRequest struct {
 	// The name of the venue
-	Name string `json:"venue.name"`
+	Name string `json:"venue.name,omitempty"`
 	// The organizer this venue belongs to (optional - leave this off to use the default organizer)
-	OrganizerID string `json:"venue.organizer_id"`
+	OrganizerID string `json:"venue.organizer_id,omitempty"`
 	// The first line of the address
-	Address1 string `json:"venue.address.address_1"`
+	Address1 string `json:"venue.address.address_1,omitempty"`
 	// The second line of the address
-	Address2 string `json:"venue.address.address_2"`
+	Address2 string `json:"venue.address.address_2,omitempty"`
 	// The city where the venue is
-	City string `json:"venue.address.city"`
+	City string `json:"venue.address.city,omitempty"`
 	// The region where the venue is
-	Region string `json:"venue.address.region"`
+	Region string `json:"venue.address.region,omitempty"`
 	// The postal_code where the venue is
-	PostalCode string `json:"venue.address.postal_code"`
+	PostalCode string `json:"venue.address.postal_code,omitempty"`
 	// The country where the venue is
-	Country string `json:"venue.address.country"`
+	Country string `json:"venue.address.country,omitempty"`
 	// The latitude of the coordinates for the venue
-	Latitude float64 `json:"venue.address.latitude"`
+	Latitude float64 `json:"venue.address.latitude,omitempty"`
 	// The longitude of the coordinates for the venue
-	Longitude float64 `json:"venue.address.longitude"`
+	Longitude float64 `json:"venue.address.longitude,omitempty"`
 	// The age restrictions for the venue
-	AgeRestriction string `json:"venue.age_restriction"`
+	AgeRestriction string `json:"venue.age_restriction,omitempty"`
 	// The max capacity for the venue
-	Capacity int `json:"venue.capacity"`
+	Capacity int `json:"venue.capacity,omitempty"`
 }
 
 // https://www.eventbrite.com/developer/v3/endpoints/venues/#ebapi-id3
@@ -39,41 +39,43 @@ type CreateVenueRequest struct {
 	// The name of the venue
 	Name string `json:"venue.name" validate:"required"`
 	// The organizer this venue belongs to (optional - leave this off to use the default organizer)
-	OrganizerID string `json:"venue.organizer_id"`
+	OrganizerID string `json:"venue.organizer_id,omitempty"`
 	// The first line of the address
-	Address1 string `json:"venue.address.address_1"`
+	Address1 string `json:"venue.address.address_1,omitempty"`
 	// The second line of the address
-	Address2 string `json:"venue.address.address_2"`
+	Address2 string `json:"venue.address.address_2,omitempty"`
 	// The city where the venue is
-	City string `json:"venue.address.city"`
+	City string `json:"venue.address.city,omitempty"`
 	// The region where the venue is
-	Region string `json:"venue.address.region"`
+	Region string `json:"venue.address.region,omitempty"`
 	// The postal_code where the venue is
-	PostalCode string `json:"venue.address.postal_code"`
+	PostalCode string `json:"venue.address.postal_code,omitempty"`
 	// The country where the venue is
-	Country string `json:"venue.address.country"`
+	Country string `json:"venue.address.country,omitempty"`
 	// The latitude of the coordinates for the venue
-	Latitude float64 `json:"venue.address.latitude"`
+	Latitude float64 `json:"venue.address.latitude,omitempty"`
 	// The longitude of the coordinates for the venue
-	Longitude float64 `json:"venue.address.longitude"`
+	Longitude float64 `json:"venue.address.longitude,omitempty"`
 	// The age restrictions for the venue
-	AgeRestriction string `json:"venue.age_restriction"`
+	AgeRestriction string `json:"venue.age_restriction,omitempty"`
 	// The max capacity for the venue
-	Capacity int `json:"venue.capacity"`
+	Capacity int `json:"venue.capacity,omitempty"`
 }
 
 type VenueEventsResult struct {
+	RawFields
+
 	Pagination Pagination `json:"pagination"`
 	Events     []Event    `json:"events"`
 }
 
 // https://www.eventbrite.com/developer/v3/endpoints/venues/#ebapi-id5
 type GetVenueEventsRequest struct {
-	Status              string `json:"status"`
-	OrderBy             string `json:"order_by"`
-	StartDateRangeStart string `json:"start_date.range_start"`
-	StartDateRangeEnd   string `json:"start_date.range_end"`
-	OnlyPublic          bool   `json:"only_public"`
+	Status              string `json:"status,omitempty"`
+	OrderBy             string `json:"order_by,omitempty"`
+	StartDateRangeStart string `json:"start_date.range_start,omitempty"`
+	StartDateRangeEnd   string `json:"start_date.range_end,omitempty"`
+	OnlyPublic          bool   `json:"only_public,omitempty"`
 }
 
 // Returns a venue object
@@ -91,7 +93,7 @@ func (c *Client) VenueGet(ctx context.Context, id string) (*Venue, error) {
 func (c *Client) VenueUpdate(ctx context.Context, id string, req *UpdateVenueRequest) (*Venue, error) {
 	res := new(Venue)
 
-	return res, c.getJSON(ctx, fmt.Sprintf("/venues/%s/", id), nil, res)
+	return res, c.postJSON(ctx, fmt.Sprintf("/venues/%s/", id), req, res)
 }
 
 // Creates a new venue with associated address
@@ -100,14 +102,25 @@ func (c *Client) VenueUpdate(ctx context.Context, id string, req *UpdateVenueReq
 func (c *Client) VenueCreate(ctx context.Context, req *CreateVenueRequest) (*Venue, error) {
 	res := new(Venue)
 
-	return res, c.postJSON(ctx, "/venues/", nil, res)
+	return res, c.postJSON(ctx, "/venues/", req, res)
 }
 
-// Creates a new venue with associated address
+// Returns events of a given venue
 //
-// https://www.eventbrite.com/developer/v3/endpoints/venues/#ebapi-post-venues
-func (c *Client) VenueEvents(ctx context.Context, venueId string) (*VenueEventsResult, error) {
+// Valid expansions: venue, organizer, format, category, subcategory, ticket_classes,
+// ticket_availability, bookmark_info, refund_policy.
+//
+// https://www.eventbrite.com/developer/v3/endpoints/venues/#ebapi-get-venues-id-events
+func (c *Client) VenueEvents(ctx context.Context, venueId string, expand ...Expansion) (*VenueEventsResult, error) {
 	res := new(VenueEventsResult)
 
-	return res, c.postJSON(ctx, fmt.Sprintf("/venues/%s/events/", venueId), nil, res)
+	return res, c.getJSON(withExpansions(ctx, expand), fmt.Sprintf("/venues/%s/events/", venueId), nil, res)
+}
+
+// VenueEventsIterator returns an iterator walking all the pages of VenueEvents
+func (c *Client) VenueEventsIterator(ctx context.Context, venueId string, expand ...Expansion) *Iterator[Event] {
+	return newIterator(ctx, func(ctx context.Context) ([]Event, Pagination, error) {
+		r, err := c.VenueEvents(ctx, venueId, expand...)
+		return r.Events, r.Pagination, err
+	})
 }
@@ -9,10 +9,12 @@ import (
 // https://www.eventbrite.com/developer/v3/endpoints/webhooks/#ebapi-id3
 type WebhooksRequest struct {
 	// The organization for which the webhooks will be fetched
-	OrganizationID string `json:"organization_id"`
+	OrganizationID string `json:"organization_id,omitempty"`
 }
 
 type WebhooksResult struct {
+	RawFields
+
 	Pagination Pagination `json:"pagination"`
 	Webhooks   []Webhook  `json:"webhooks"`
 }
@@ -20,15 +22,15 @@ type WebhooksResult struct {
 // https://www.eventbrite.com/developer/v3/endpoints/webhooks/#ebapi-id5
 type CreateWebhookRequest struct {
 	// The target URL of the Webhook subscription
-	EndpointUrl string `json:"endpoint_url"`
+	EndpointUrl string `json:"endpoint_url,omitempty"`
 	// Determines what actions will trigger the webhook. If no value is sent for this param, it selects
 	// order.placed, event.published, and event.unpublished by default. See below for a more complete
 	// description of all available actions
-	Actions string `json:"actions"`
+	Actions string `json:"actions,omitempty"`
 	// The organization under which the webhook management is scoped
-	OrganizationID string `json:"organization_id"`
+	OrganizationID string `json:"organization_id,omitempty"`
 	// The ID of the event that triggers this webhook. Leave blank for all events
-	EventID string `json:"event_id"`
+	EventID string `json:"event_id,omitempty"`
 }
 
 // Returns a webhook for the specified webhook as webhook
@@ -58,6 +60,14 @@ func (c *Client) Webhooks(ctx context.Context, req *WebhooksRequest) (*WebhooksR
 	return res, c.getJSON(ctx, fmt.Sprintf("/webhooks/"), req, res)
 }
 
+// WebhooksIterator returns an iterator walking all the pages of Webhooks
+func (c *Client) WebhooksIterator(ctx context.Context, req *WebhooksRequest) *Iterator[Webhook] {
+	return newIterator(ctx, func(ctx context.Context) ([]Webhook, Pagination, error) {
+		r, err := c.Webhooks(ctx, req)
+		return r.Webhooks, r.Pagination, err
+	})
+}
+
 // Creates a webhook for the authenticated user
 //
 // https://www.eventbrite.com/developer/v3/endpoints/webhooks/#ebapi-post-webhooks